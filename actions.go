@@ -0,0 +1,141 @@
+package pihole
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type Actions interface {
+	// UpdateGravity runs gravity, passing each line of output to onLine as it is streamed. ErrorGravityFailed
+	// is returned when the run fails, while lists that could not be downloaded are reported in the result.
+	UpdateGravity(ctx context.Context, onLine func(line string)) (*GravityResult, error)
+
+	// RestartDNS restarts the Pi-hole DNS resolver.
+	RestartDNS(ctx context.Context) error
+
+	// FlushLogs empties the DNS logs and the last 24 hours of the query database.
+	FlushLogs(ctx context.Context) error
+
+	// FlushNetwork empties the network table, including the ARP cache.
+	FlushNetwork(ctx context.Context) error
+}
+
+var (
+	ErrorGravityFailed   = errors.New("gravity update failed")
+	ErrorActionForbidden = errors.New("action forbidden")
+)
+
+// GravityResult describes a completed gravity run. FailedLists holds the addresses of subscriptions that
+// could not be downloaded, for which gravity keeps the previously cached copy when there is one.
+type GravityResult struct {
+	FailedLists []string
+}
+
+const (
+	// gravityCross marks a failed step in gravity output, it may be wrapped in color codes
+	gravityCross = "✗"
+
+	// gravityFatal follows the cross on steps that abort the run
+	gravityFatal = "Unable to"
+
+	gravityTarget     = "Target: "
+	gravityListFailed = "List download failed"
+)
+
+type actions struct {
+	client *Client
+}
+
+// UpdateGravity rebuilds the gravity database and streams its output line by line
+func (a actions) UpdateGravity(ctx context.Context, onLine func(line string)) (*GravityResult, error) {
+	res, err := a.client.Post(ctx, "/api/action/gravity", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %w", ErrorGravityFailed, unexpectedStatus(res))
+	}
+
+	result := &GravityResult{}
+
+	var target, failure string
+
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if _, address, ok := strings.Cut(line, gravityTarget); ok {
+			target = strings.TrimSpace(address)
+		}
+
+		if strings.Contains(line, gravityCross) {
+			switch {
+			case strings.Contains(line, gravityListFailed):
+				result.FailedLists = append(result.FailedLists, target)
+			case failure == "" && strings.Contains(line, gravityFatal):
+				failure = line
+			}
+		}
+
+		if onLine != nil {
+			onLine(line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, fmt.Errorf("%w: failed to read output: %w", ErrorGravityFailed, err)
+	}
+
+	if failure != "" {
+		_, message, _ := strings.Cut(failure, gravityFatal)
+		return nil, fmt.Errorf("%w: %s%s", ErrorGravityFailed, gravityFatal, message)
+	}
+
+	return result, nil
+}
+
+// RestartDNS restarts the Pi-hole DNS resolver
+func (a actions) RestartDNS(ctx context.Context) error {
+	return a.run(ctx, "/api/action/restartdns")
+}
+
+// FlushLogs empties the DNS logs and the last 24 hours of the query database
+func (a actions) FlushLogs(ctx context.Context) error {
+	return a.run(ctx, "/api/action/flush/logs")
+}
+
+// FlushNetwork empties the network table, including the ARP cache
+func (a actions) FlushNetwork(ctx context.Context) error {
+	return a.run(ctx, "/api/action/flush/arp")
+}
+
+// run posts an action, returning ErrorActionForbidden when the server refuses to perform it, such as
+// when webserver.api.allow_destructive is disabled
+func (a actions) run(ctx context.Context, path string) error {
+	res, err := a.client.Post(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusForbidden:
+		b, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("%w: %s %s", ErrorActionForbidden, path, string(b))
+	default:
+		return unexpectedStatus(res)
+	}
+}
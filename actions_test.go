@@ -0,0 +1,174 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsUpdateGravity(t *testing.T) {
+	t.Run("Test streams gravity output", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/action/gravity", r.URL.Path)
+
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "  [i] Neutrino emissions detected...\r\n  [✓] Done.\n")
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		var lines []string
+		result, err := c.Actions.UpdateGravity(context.Background(), func(line string) {
+			lines = append(lines, line)
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"  [i] Neutrino emissions detected...", "  [✓] Done."}, lines)
+		assert.Empty(t, result.FailedLists)
+	})
+
+	t.Run("Test returns an error on failure", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		_, err = c.Actions.UpdateGravity(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrorGravityFailed)
+	})
+
+	t.Run("Test returns an error when the output reports a failure", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "  [i] Creating new gravity database\n  [\x1b[1;31m✗\x1b[0m] Unable to create gravity database\n  [i] Done.\n")
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		var lines []string
+		_, err = c.Actions.UpdateGravity(context.Background(), func(line string) {
+			lines = append(lines, line)
+		})
+		assert.ErrorIs(t, err, ErrorGravityFailed)
+		assert.ErrorContains(t, err, "Unable to create gravity database")
+		assert.Len(t, lines, 3)
+	})
+
+	t.Run("Test reports failed lists when the run completes", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, strings.Join([]string{
+				"  [i] Target: https://lists.example.com/ads.txt",
+				"  [\x1b[1;32m✓\x1b[0m] Status: Retrieval successful",
+				"  [i] Target: https://unreachable.example.com/hosts",
+				"  [\x1b[1;31m✗\x1b[0m] Status: Connection Refused",
+				"  [\x1b[1;31m✗\x1b[0m] List download failed: using previously cached list",
+				"  [\x1b[1;32m✓\x1b[0m] Building tree",
+				"  [\x1b[1;32m✓\x1b[0m] Done.",
+			}, "\n"))
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		result, err := c.Actions.UpdateGravity(context.Background(), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"https://unreachable.example.com/hosts"}, result.FailedLists)
+	})
+
+	t.Run("Test update gravity", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		var lines []string
+		_, err := c.Actions.UpdateGravity(context.Background(), func(line string) {
+			lines = append(lines, line)
+		})
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, lines)
+	})
+}
+
+func TestActionsMaintenance(t *testing.T) {
+	t.Run("Test returns an error when the action is forbidden", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/action/restartdns", r.URL.Path)
+
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"key":"forbidden","message":"Action not allowed"}}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		err = c.Actions.RestartDNS(context.Background())
+		assert.ErrorIs(t, err, ErrorActionForbidden)
+	})
+
+	t.Run("Test keeps the action error for app password sessions", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"key":"forbidden","message":"Destructive API actions are disabled","hint":"webserver.api.allow_destructive is false"}}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test", AppPassword: true})
+		require.NoError(t, err)
+
+		err = c.Actions.RestartDNS(context.Background())
+		assert.ErrorIs(t, err, ErrorActionForbidden)
+		assert.NotErrorIs(t, err, ErrorAppPasswordForbidden)
+		assert.ErrorContains(t, err, "allow_destructive")
+	})
+
+	t.Run("Test run maintenance actions", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		require.NoError(t, c.Actions.FlushNetwork(ctx))
+		require.NoError(t, c.Actions.FlushLogs(ctx))
+		require.NoError(t, c.Actions.RestartDNS(ctx))
+	})
+}
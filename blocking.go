@@ -0,0 +1,120 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Blocking interface {
+	// Get the current blocking status.
+	Get(ctx context.Context) (*BlockingStatus, error)
+
+	// Enable blocking.
+	Enable(ctx context.Context) (*BlockingStatus, error)
+
+	// Disable blocking, re-enabling it after the duration. A zero duration disables blocking indefinitely.
+	Disable(ctx context.Context, duration time.Duration) (*BlockingStatus, error)
+}
+
+// BlockingState is the state of Pi-hole's blocking
+type BlockingState string
+
+const (
+	BlockingStateEnabled  BlockingState = "enabled"
+	BlockingStateDisabled BlockingState = "disabled"
+	BlockingStateFailed   BlockingState = "failed"
+	BlockingStateUnknown  BlockingState = "unknown"
+)
+
+type blocking struct {
+	client *Client
+}
+
+// BlockingStatus is the blocking state and the time remaining until it is toggled back, if a timer is set
+type BlockingStatus struct {
+	State BlockingState
+	Timer time.Duration
+}
+
+type blockingResponse struct {
+	Blocking BlockingState `json:"blocking"`
+	Timer    *float64      `json:"timer"`
+}
+
+type blockingRequest struct {
+	Blocking bool     `json:"blocking"`
+	Timer    *float64 `json:"timer"`
+}
+
+func (res blockingResponse) toBlockingStatus() BlockingStatus {
+	status := BlockingStatus{State: res.Blocking}
+
+	if res.Timer != nil {
+		status.Timer = time.Duration(*res.Timer * float64(time.Second))
+	}
+
+	return status
+}
+
+// Get returns the current blocking status
+func (b blocking) Get(ctx context.Context) (*BlockingStatus, error) {
+	res, err := b.client.Get(ctx, "/api/dns/blocking")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var blockingRes blockingResponse
+	if err := json.NewDecoder(res.Body).Decode(&blockingRes); err != nil {
+		return nil, fmt.Errorf("failed to parse blocking body: %w", err)
+	}
+
+	status := blockingRes.toBlockingStatus()
+
+	return &status, nil
+}
+
+// Enable enables blocking
+func (b blocking) Enable(ctx context.Context) (*BlockingStatus, error) {
+	return b.set(ctx, blockingRequest{Blocking: true})
+}
+
+// Disable disables blocking for the duration, or indefinitely if the duration is zero
+func (b blocking) Disable(ctx context.Context, duration time.Duration) (*BlockingStatus, error) {
+	req := blockingRequest{Blocking: false}
+
+	if duration > 0 {
+		timer := duration.Seconds()
+		req.Timer = &timer
+	}
+
+	return b.set(ctx, req)
+}
+
+func (b blocking) set(ctx context.Context, req blockingRequest) (*BlockingStatus, error) {
+	res, err := b.client.Post(ctx, "/api/dns/blocking", req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var blockingRes blockingResponse
+	if err := json.NewDecoder(res.Body).Decode(&blockingRes); err != nil {
+		return nil, fmt.Errorf("failed to parse blocking response body: %w", err)
+	}
+
+	status := blockingRes.toBlockingStatus()
+
+	return &status, nil
+}
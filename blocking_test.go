@@ -0,0 +1,60 @@
+package pihole
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cleanupBlocking(t *testing.T, c *Client) {
+	if _, err := c.Blocking.Enable(context.TODO()); err != nil {
+		log.Printf("Failed to re-enable blocking: %s\n", err)
+	}
+}
+
+func TestBlocking(t *testing.T) {
+	t.Run("Test disable blocking with a timer", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		status, err := c.Blocking.Disable(ctx, 5*time.Minute)
+		require.NoError(t, err)
+		defer cleanupBlocking(t, c)
+
+		assert.Equal(t, BlockingStateDisabled, status.State)
+		assert.InDelta(t, 5*time.Minute, status.Timer, float64(5*time.Second))
+
+		status, err = c.Blocking.Get(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, BlockingStateDisabled, status.State)
+		assert.NotZero(t, status.Timer)
+	})
+
+	t.Run("Test enable blocking", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		_, err := c.Blocking.Disable(ctx, 0)
+		require.NoError(t, err)
+		defer cleanupBlocking(t, c)
+
+		status, err := c.Blocking.Enable(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, BlockingStateEnabled, status.State)
+		assert.Zero(t, status.Timer)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 )
@@ -21,8 +22,40 @@ type Config struct {
 	SessionID  string
 	HttpClient *http.Client
 	Headers    http.Header
+
+	// OnRelogin is called after the client logs in again because its session expired
+	OnRelogin func(session Session)
+
+	// SessionRenewalMargin is how long before its expiration the session is renewed. Defaults to 30 seconds
+	// and is capped at half the session validity.
+	SessionRenewalMargin time.Duration
+
+	// TOTP provides the two-factor code when two-factor authentication is enabled, see StaticTOTP and TOTPFromSecret
+	TOTP TOTPSource
+
+	// AppPassword indicates the Password is an application password, whose sessions may not be allowed
+	// to change the configuration
+	AppPassword bool
+
+	// AuthMode selects how the session is sent, defaults to AuthModeHeader
+	AuthMode AuthMode
+
+	// CSRF is the token belonging to SessionID, required when using AuthModeCookie with a SessionID
+	CSRF string
 }
 
+// AuthMode is how the client sends its session to Pi-hole
+type AuthMode string
+
+const (
+	// AuthModeHeader sends the session ID in the X-FTL-SID header
+	AuthModeHeader AuthMode = "header"
+
+	// AuthModeCookie sends the session ID in the sid cookie along with the X-FTL-CSRF header, for use
+	// behind reverse proxies that strip custom headers
+	AuthModeCookie AuthMode = "cookie"
+)
+
 type Client struct {
 	baseURL         string
 	password        string
@@ -30,20 +63,60 @@ type Client struct {
 	http            *http.Client
 	auth            auth
 	publicEndpoints map[string]bool
+	onRelogin       func(session Session)
+	renewalMargin   time.Duration
+	totp            TOTPSource
+	appPassword     bool
+	authMode        AuthMode
 
 	sessionLock sync.RWMutex
 
 	LocalDNS   LocalDNS
 	LocalCNAME LocalCNAME
 	SessionAPI SessionAPI
+	Domains    Domains
+	Groups     Groups
+	Clients    Clients
+	Lists      Lists
+	Actions    Actions
+	Blocking   Blocking
+	Queries    Queries
+	Stats      Stats
+	History    History
+	ConfigAPI  ConfigAPI
+	Upstreams  Upstreams
+	Teleporter Teleporter
+	DHCP       DHCP
+	Network    Network
+	Info       Info
+	Messages   Messages
+	Logs       Logs
 }
 
 type auth struct {
-	sid string
+	sid      string
+	session  Session
+	validity time.Duration
+}
+
+// set stores a new session. Pi-hole extends a session by its validity on every authenticated request.
+func (a *auth) set(session Session) {
+	a.sid = session.SID
+	a.session = session
+	a.validity = time.Until(session.Expiration).Round(time.Second)
+}
+
+// expiresWithin reports whether the session is known to expire within the margin
+func (a *auth) expiresWithin(margin time.Duration) bool {
+	return !a.session.Expiration.IsZero() && time.Now().Add(margin).After(a.session.Expiration)
 }
 
 const (
 	authHeader = "X-FTL-SID"
+	csrfHeader = "X-FTL-CSRF"
+	authCookie = "sid"
+
+	defaultSessionRenewalMargin = 30 * time.Second
 )
 
 // New returns a new Pi-hole client
@@ -66,11 +139,37 @@ func New(config Config) (*Client, error) {
 		}
 	}
 
+	authMode := config.AuthMode
+	switch authMode {
+	case "":
+		authMode = AuthModeHeader
+	case AuthModeHeader:
+	case AuthModeCookie:
+		if config.SessionID != "" && config.CSRF == "" {
+			return nil, fmt.Errorf("%w: CSRF is required with a SessionID in cookie auth mode", ErrClientValidation)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown auth mode %q", ErrClientValidation, authMode)
+	}
+
+	renewalMargin := config.SessionRenewalMargin
+	switch {
+	case renewalMargin < 0:
+		return nil, fmt.Errorf("%w: SessionRenewalMargin must not be negative", ErrClientValidation)
+	case renewalMargin == 0:
+		renewalMargin = defaultSessionRenewalMargin
+	}
+
 	client := &Client{
-		baseURL:  baseURL,
-		http:     httpClient,
-		headers:  headers,
-		password: config.Password,
+		baseURL:       baseURL,
+		http:          httpClient,
+		headers:       headers,
+		password:      config.Password,
+		onRelogin:     config.OnRelogin,
+		renewalMargin: renewalMargin,
+		totp:          config.TOTP,
+		appPassword:   config.AppPassword,
+		authMode:      authMode,
 		publicEndpoints: map[string]bool{
 			"POST /api/auth": true,
 		},
@@ -78,27 +177,298 @@ func New(config Config) (*Client, error) {
 
 	if config.SessionID != "" {
 		client.auth.sid = config.SessionID
+		client.auth.session = Session{SID: config.SessionID, CSRF: config.CSRF}
 	}
 
 	client.LocalDNS = &localDNS{client: client}
 	client.LocalCNAME = &localCNAME{client: client}
 	client.SessionAPI = &sessionAPI{client: client}
+	client.Domains = &domains{client: client}
+	client.Groups = &groups{client: client}
+	client.Clients = &clients{client: client}
+	client.Lists = &lists{client: client}
+	client.Actions = &actions{client: client}
+	client.Blocking = &blocking{client: client}
+	client.Queries = &queries{client: client}
+	client.Stats = &stats{client: client}
+	client.History = &history{client: client}
+	client.ConfigAPI = &configAPI{client: client}
+	client.Upstreams = &upstreams{client: client}
+	client.Teleporter = &teleporter{client: client}
+	client.DHCP = &dhcp{client: client}
+	client.Network = &network{client: client}
+	client.Info = &info{client: client}
+	client.Messages = &messages{client: client}
+	client.Logs = &logs{client: client}
 
 	return client, nil
 }
 
 var ErrClientValidation = errors.New("invalid client configuration")
 
+// processedResponse reports the per item outcome of a batch create or update
+type processedResponse struct {
+	Success []processedItem `json:"success"`
+	Errors  []processedItem `json:"errors"`
+}
+
+type processedItem struct {
+	Item  string `json:"item"`
+	Error string `json:"error,omitempty"`
+}
+
+func (p *processedResponse) err() error {
+	if p == nil || len(p.Errors) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(p.Errors))
+	for i, e := range p.Errors {
+		msgs[i] = fmt.Sprintf("%s: %s", e.Item, e.Error)
+	}
+
+	return errors.New(strings.Join(msgs, ", "))
+}
+
+// isAppSudoRefusal reports whether a forbidden response was caused by webserver.api.app_sudo being disabled,
+// which keeps application password sessions from changing the configuration
+func isAppSudoRefusal(body []byte) bool {
+	var res struct {
+		Error sessionErrorResponse `json:"error"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return false
+	}
+
+	return strings.Contains(res.Error.Message, "app_sudo") || strings.Contains(res.Error.Hint, "app_sudo")
+}
+
+// getJSON requests a path and decodes a successful response into v
+func (c *Client) getJSON(ctx context.Context, path string, v interface{}) error {
+	res, err := c.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return unexpectedStatus(res)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s body: %w", path, err)
+	}
+
+	return nil
+}
+
+// unexpectedStatus reads the response body into an error for an unhandled status code
+func unexpectedStatus(res *http.Response) error {
+	b, _ := io.ReadAll(res.Body)
+	return fmt.Errorf("received unexpected status code %d %s", res.StatusCode, string(b))
+}
+
 func (c *Client) request(ctx context.Context, method string, path string, body interface{}) (*http.Response, error) {
-	url := c.baseURL + path
+	if body == nil {
+		return c.send(ctx, method, path, nil, "")
+	}
 
-	var reqBody io.Reader
-	if body != nil {
-		jsonData, err := json.Marshal(body)
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.send(ctx, method, path, jsonData, "application/json")
+}
+
+// send performs an authenticated request with a raw body of the given content type. When the session has
+// expired the client logs in again and replays the request once.
+func (c *Client) send(ctx context.Context, method string, path string, body []byte, contentType string) (*http.Response, error) {
+	_, public := c.publicEndpoints[fmt.Sprintf("%s %s", method, path)]
+
+	var SID, CSRF string
+	if !public {
+		sid, err := c.sessionID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		SID = sid
+		CSRF = c.csrf(SID)
+	}
+
+	res, err := c.do(ctx, method, path, body, contentType, SID, CSRF)
+	if err != nil {
+		return nil, err
+	}
+
+	if public {
+		return res, nil
+	}
+
+	if res.StatusCode == http.StatusUnauthorized && c.password != "" {
+		res.Body.Close()
+
+		SID, err = c.relogin(ctx, SID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to login after session expired: %w", err)
+		}
+
+		res, err = c.do(ctx, method, path, body, contentType, SID, c.csrf(SID))
 		if err != nil {
 			return nil, err
 		}
-		reqBody = bytes.NewBuffer(jsonData)
+	}
+
+	if res.StatusCode != http.StatusUnauthorized {
+		c.extendSession(SID)
+	}
+
+	if res.StatusCode == http.StatusForbidden && c.appPassword {
+		b, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response %s %s: %w", method, path, err)
+		}
+
+		if isAppSudoRefusal(b) {
+			return nil, fmt.Errorf("%w: %s %s: %s", ErrorAppPasswordForbidden, method, path, string(b))
+		}
+
+		// other refusals, such as destructive actions being disabled, are left to the service
+		res.Body = io.NopCloser(bytes.NewReader(b))
+	}
+
+	return res, nil
+}
+
+// CurrentSession returns the session the client authenticates with. The expiration is zero when the
+// session was passed in through Config.SessionID.
+func (c *Client) CurrentSession() Session {
+	c.sessionLock.RLock()
+	defer c.sessionLock.RUnlock()
+
+	return c.auth.session
+}
+
+// needsLogin reports whether the client has no session or one that expires within the renewal margin
+func (c *Client) needsLogin() bool {
+	return c.auth.sid == "" || c.password != "" && c.auth.expiresWithin(c.sessionRenewalMargin())
+}
+
+// sessionRenewalMargin caps the configured margin at half the session validity, so a session is not
+// renewed on every request
+func (c *Client) sessionRenewalMargin() time.Duration {
+	if c.auth.validity > 0 && c.renewalMargin > c.auth.validity/2 {
+		return c.auth.validity / 2
+	}
+
+	return c.renewalMargin
+}
+
+// sessionID returns the current session ID, logging in if the client has no session yet or renewing the
+// session when it is about to expire
+func (c *Client) sessionID(ctx context.Context) (string, error) {
+	c.sessionLock.RLock()
+	SID := c.auth.sid
+	renew := c.needsLogin()
+	c.sessionLock.RUnlock()
+
+	if !renew {
+		return SID, nil
+	}
+
+	c.sessionLock.Lock()
+
+	// recheck client directly to make sure
+	if !c.needsLogin() {
+		SID = c.auth.sid
+		c.sessionLock.Unlock()
+
+		return SID, nil
+	}
+
+	previous := c.auth.session
+	_, err := c.SessionAPI.Login(ctx)
+	SID = c.auth.sid
+	c.sessionLock.Unlock()
+
+	if err != nil {
+		return "", fmt.Errorf("failed to login: %w", err)
+	}
+
+	if previous.SID != "" {
+		c.endSession(ctx, previous)
+	}
+
+	return SID, nil
+}
+
+// endSession logs out a session that was replaced while it was still valid, so it does not keep taking
+// one of the API session slots until it expires. Failures are ignored as the session expires regardless.
+func (c *Client) endSession(ctx context.Context, session Session) {
+	res, err := c.do(ctx, http.MethodDelete, "/api/auth", nil, "", session.SID, session.CSRF)
+	if err != nil {
+		return
+	}
+	res.Body.Close()
+}
+
+// csrf returns the CSRF token of the session, which is empty once the session has been replaced
+func (c *Client) csrf(SID string) string {
+	c.sessionLock.RLock()
+	defer c.sessionLock.RUnlock()
+
+	if c.auth.sid != SID {
+		return ""
+	}
+
+	return c.auth.session.CSRF
+}
+
+// extendSession slides the expiration of the session forward after it was used successfully
+func (c *Client) extendSession(SID string) {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	if c.auth.sid == SID && c.auth.validity > 0 {
+		c.auth.session.Expiration = time.Now().Add(c.auth.validity)
+	}
+}
+
+// relogin replaces an expired session ID. Concurrent requests that fail with the same session share a
+// single login.
+func (c *Client) relogin(ctx context.Context, expired string) (string, error) {
+	c.sessionLock.Lock()
+
+	if c.auth.sid != "" && c.auth.sid != expired {
+		SID := c.auth.sid
+		c.sessionLock.Unlock()
+
+		return SID, nil
+	}
+
+	session, err := c.SessionAPI.Login(ctx)
+	SID := c.auth.sid
+	c.sessionLock.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+
+	if c.onRelogin != nil {
+		c.onRelogin(session)
+	}
+
+	return SID, nil
+}
+
+// do sends a single request, authenticated with the session ID when one is passed
+func (c *Client) do(ctx context.Context, method string, path string, body []byte, contentType string, SID string, CSRF string) (*http.Response, error) {
+	url := c.baseURL + path
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
@@ -106,31 +476,22 @@ func (c *Client) request(ctx context.Context, method string, path string, body i
 		return nil, fmt.Errorf("failed to create req with context %s %s: %w", method, path, err)
 	}
 
-	if _, ok := c.publicEndpoints[fmt.Sprintf("%s %s", method, path)]; !ok {
-		c.sessionLock.RLock()
-		SID := c.auth.sid
-		c.sessionLock.RUnlock()
-
-		if SID == "" {
-			c.sessionLock.Lock()
-			defer c.sessionLock.Unlock()
-
-			// recheck client directly to make sure
-			if c.auth.sid == "" {
-				if _, err := c.SessionAPI.Login(ctx); err != nil {
-					return nil, fmt.Errorf("failed to login: %w", err)
-				}
-			}
+	if SID != "" {
+		switch c.authMode {
+		case AuthModeCookie:
+			req.AddCookie(&http.Cookie{Name: authCookie, Value: SID})
+			req.Header.Set(csrfHeader, CSRF)
+		default:
+			req.Header[authHeader] = []string{SID}
 		}
-		req.Header[authHeader] = []string{c.auth.sid}
 	}
 
 	for key, header := range c.headers {
 		req.Header[key] = header
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	res, err := c.http.Do(req)
@@ -153,6 +514,10 @@ func (c *Client) Put(ctx context.Context, path string, body interface{}) (*http.
 	return c.request(ctx, http.MethodPut, path, body)
 }
 
+func (c *Client) Patch(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	return c.request(ctx, http.MethodPatch, path, body)
+}
+
 func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error) {
 	return c.request(ctx, http.MethodDelete, path, nil)
 }
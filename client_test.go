@@ -4,8 +4,14 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +30,280 @@ func TestClientValidation(t *testing.T) {
 
 		assert.NoError(t, err)
 	})
+
+	t.Run("error on negative session renewal margin", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		_, err := New(Config{
+			BaseURL:              "http://localhost:8080",
+			Password:             "test",
+			SessionRenewalMargin: -time.Second,
+		})
+
+		assert.ErrorIs(t, err, ErrClientValidation)
+	})
+
+	t.Run("error on unknown auth mode", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		_, err := New(Config{
+			BaseURL:  "http://localhost:8080",
+			Password: "test",
+			AuthMode: "query",
+		})
+
+		assert.ErrorIs(t, err, ErrClientValidation)
+	})
+
+	t.Run("error on cookie auth mode session without CSRF", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		_, err := New(Config{
+			BaseURL:   "http://localhost:8080",
+			SessionID: "test",
+			AuthMode:  AuthModeCookie,
+		})
+
+		assert.ErrorIs(t, err, ErrClientValidation)
+	})
+}
+
+func TestClientCookieAuth(t *testing.T) {
+	t.Run("Test sends the session cookie and CSRF header", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/auth" {
+				assert.Empty(t, r.Cookies())
+
+				fmt.Fprint(w, `{"session":{"valid":true,"sid":"cookie-sid","csrf":"cookie-csrf","validity":300}}`)
+				return
+			}
+
+			cookie, err := r.Cookie("sid")
+			require.NoError(t, err)
+
+			assert.Equal(t, "cookie-sid", cookie.Value)
+			assert.Equal(t, "cookie-csrf", r.Header.Get("X-FTL-CSRF"))
+			assert.Empty(t, r.Header.Get(authHeader))
+
+			fmt.Fprint(w, `{"blocking":"enabled","timer":null}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test", AuthMode: AuthModeCookie})
+		require.NoError(t, err)
+
+		status, err := c.Blocking.Get(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, BlockingStateEnabled, status.State)
+	})
+}
+
+func TestClientRelogin(t *testing.T) {
+	t.Run("Test logs in again and replays the request when the session expired", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var logins int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/auth" {
+				atomic.AddInt32(&logins, 1)
+				fmt.Fprint(w, `{"session":{"valid":true,"sid":"fresh","validity":300}}`)
+				return
+			}
+
+			if r.Header.Get(authHeader) != "fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			b, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"blocking":false,"timer":60}`, string(b))
+
+			fmt.Fprint(w, `{"blocking":"disabled","timer":60}`)
+		}))
+		defer server.Close()
+
+		var relogins []Session
+
+		c, err := New(Config{
+			BaseURL:   server.URL,
+			Password:  "test",
+			SessionID: "expired",
+			OnRelogin: func(session Session) {
+				relogins = append(relogins, session)
+			},
+		})
+		require.NoError(t, err)
+
+		status, err := c.Blocking.Disable(context.Background(), time.Minute)
+		require.NoError(t, err)
+
+		assert.Equal(t, BlockingStateDisabled, status.State)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+		require.Len(t, relogins, 1)
+		assert.Equal(t, "fresh", relogins[0].SID)
+		assert.Equal(t, "fresh", c.auth.sid)
+	})
+
+	t.Run("Test returns the response when no password is configured", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEqual(t, "/api/auth", r.URL.Path)
+
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "expired"})
+		require.NoError(t, err)
+
+		res, err := c.Get(context.Background(), "/api/dns/blocking")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+func TestClientSessionRenewal(t *testing.T) {
+	t.Run("Test renews the session before it expires and ends the previous session", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var logins int32
+		var ended []string
+		var mu sync.Mutex
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/api/auth":
+				n := atomic.AddInt32(&logins, 1)
+				fmt.Fprintf(w, `{"session":{"valid":true,"sid":"sid-%d","validity":300}}`, n)
+			case r.Method == http.MethodDelete && r.URL.Path == "/api/auth":
+				mu.Lock()
+				ended = append(ended, r.Header.Get(authHeader))
+				mu.Unlock()
+
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				assert.Equal(t, fmt.Sprintf("sid-%d", atomic.LoadInt32(&logins)), r.Header.Get(authHeader))
+
+				fmt.Fprint(w, `{"blocking":"enabled","timer":null}`)
+			}
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test"})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		_, err = c.Blocking.Get(ctx)
+		require.NoError(t, err)
+
+		c.sessionLock.Lock()
+		c.auth.session.Expiration = time.Now().Add(10 * time.Second)
+		c.sessionLock.Unlock()
+
+		_, err = c.Blocking.Get(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&logins))
+		assert.Equal(t, "sid-2", c.CurrentSession().SID)
+		assert.Equal(t, []string{"sid-1"}, ended)
+	})
+
+	t.Run("Test caps the renewal margin at half the session validity", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var logins int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/auth" {
+				atomic.AddInt32(&logins, 1)
+				fmt.Fprint(w, `{"session":{"valid":true,"sid":"test","validity":60}}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"blocking":"enabled","timer":null}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{
+			BaseURL:              server.URL,
+			Password:             "test",
+			SessionRenewalMargin: 2 * time.Minute,
+		})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			_, err = c.Blocking.Get(ctx)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+	})
+
+	t.Run("Test extends the session expiration after each request", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var logins int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/auth" {
+				atomic.AddInt32(&logins, 1)
+				fmt.Fprint(w, `{"session":{"valid":true,"sid":"test","validity":300}}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"blocking":"enabled","timer":null}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test"})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		session, err := c.SessionAPI.Login(ctx)
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+
+		_, err = c.Blocking.Get(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+		assert.True(t, c.CurrentSession().Expiration.After(session.Expiration))
+	})
+
+	t.Run("Test never renews a provided session ID", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		c, err := New(Config{BaseURL: "http://localhost:8080", Password: "test", SessionID: "provided"})
+		require.NoError(t, err)
+
+		SID, err := c.sessionID(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "provided", SID)
+		assert.True(t, c.CurrentSession().Expiration.IsZero())
+	})
 }
 
 func isAcceptance(t *testing.T) {
@@ -0,0 +1,326 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type Clients interface {
+	// List all registered clients.
+	List(ctx context.Context) (ClientEntryList, error)
+
+	// Get a registered client by its IP, MAC, hostname or interface.
+	Get(ctx context.Context, client string) (*ClientEntry, error)
+
+	// Create registers a client.
+	Create(ctx context.Context, req ClientRequest) (*ClientEntry, error)
+
+	// Update replaces the comment and group membership of a registered client. Nil groups keep the current
+	// membership and an empty slice removes the client from every group.
+	Update(ctx context.Context, req ClientRequest) (*ClientEntry, error)
+
+	// Delete a registered client.
+	Delete(ctx context.Context, client string) error
+
+	// Suggestions lists devices seen by Pi-hole that are not yet registered as clients.
+	Suggestions(ctx context.Context) (ClientSuggestionList, error)
+}
+
+var (
+	ErrorClientNotFound = errors.New("client not found")
+)
+
+type clients struct {
+	client *Client
+}
+
+// ClientEntry is a client registered with Pi-hole. Client holds the IP, subnet, MAC, hostname or
+// interface (prefixed with ":") the entry matches.
+type ClientEntry struct {
+	ID           int
+	Client       string
+	Name         string
+	Comment      string
+	Groups       []int
+	DateAdded    time.Time
+	DateModified time.Time
+}
+
+type ClientEntryList []ClientEntry
+
+type ClientRequest struct {
+	Client  string
+	Comment string
+	Groups  []int
+}
+
+type ClientSuggestion struct {
+	HWAddr    string
+	MACVendor string
+	LastQuery time.Time
+	Addresses []string
+	Names     []string
+}
+
+type ClientSuggestionList []ClientSuggestion
+
+type clientListResponse struct {
+	Clients   []clientResponse   `json:"clients"`
+	Processed *processedResponse `json:"processed"`
+}
+
+type clientResponse struct {
+	ID           int     `json:"id"`
+	Client       string  `json:"client"`
+	Name         *string `json:"name"`
+	Comment      *string `json:"comment"`
+	Groups       []int   `json:"groups"`
+	DateAdded    int64   `json:"date_added"`
+	DateModified int64   `json:"date_modified"`
+}
+
+type clientCreateRequest struct {
+	Client  string `json:"client"`
+	Comment string `json:"comment"`
+	Groups  []int  `json:"groups,omitempty"`
+}
+
+type clientUpdateRequest struct {
+	Comment string `json:"comment"`
+	Groups  *[]int `json:"groups,omitempty"`
+}
+
+type clientSuggestionListResponse struct {
+	Clients []clientSuggestionResponse `json:"clients"`
+}
+
+type clientSuggestionResponse struct {
+	HWAddr    string  `json:"hwaddr"`
+	MACVendor *string `json:"macVendor"`
+	LastQuery int64   `json:"lastQuery"`
+	Addresses *string `json:"addresses"`
+	Names     *string `json:"names"`
+}
+
+func (res clientResponse) toClientEntry() ClientEntry {
+	c := ClientEntry{
+		ID:           res.ID,
+		Client:       res.Client,
+		Groups:       res.Groups,
+		DateAdded:    time.Unix(res.DateAdded, 0),
+		DateModified: time.Unix(res.DateModified, 0),
+	}
+
+	if res.Name != nil {
+		c.Name = *res.Name
+	}
+
+	if res.Comment != nil {
+		c.Comment = *res.Comment
+	}
+
+	return c
+}
+
+func (res clientListResponse) toClientEntryList() ClientEntryList {
+	list := make(ClientEntryList, len(res.Clients))
+
+	for i, c := range res.Clients {
+		list[i] = c.toClientEntry()
+	}
+
+	return list
+}
+
+// splitList splits a comma separated list, returning nil for a missing or empty value
+func splitList(value *string) []string {
+	if value == nil || *value == "" {
+		return nil
+	}
+
+	return strings.Split(*value, ",")
+}
+
+func (res clientSuggestionListResponse) toClientSuggestionList() ClientSuggestionList {
+	list := make(ClientSuggestionList, len(res.Clients))
+
+	for i, s := range res.Clients {
+		suggestion := ClientSuggestion{
+			HWAddr:    s.HWAddr,
+			LastQuery: time.Unix(s.LastQuery, 0),
+			Addresses: splitList(s.Addresses),
+			Names:     splitList(s.Names),
+		}
+
+		if s.MACVendor != nil {
+			suggestion.MACVendor = *s.MACVendor
+		}
+
+		list[i] = suggestion
+	}
+
+	return list
+}
+
+// List returns all registered clients
+func (c clients) List(ctx context.Context) (ClientEntryList, error) {
+	res, err := c.client.Get(ctx, "/api/clients")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList clientListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse client list body: %w", err)
+	}
+
+	return resList.toClientEntryList(), nil
+}
+
+// Get returns a registered client
+func (c clients) Get(ctx context.Context, client string) (*ClientEntry, error) {
+	res, err := c.client.Get(ctx, fmt.Sprintf("/api/clients/%s", url.PathEscape(client)))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorClientNotFound, client)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList clientListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse client body: %w", err)
+	}
+
+	if len(resList.Clients) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorClientNotFound, client)
+	}
+
+	entry := resList.Clients[0].toClientEntry()
+
+	return &entry, nil
+}
+
+// Create registers a client
+func (c clients) Create(ctx context.Context, req ClientRequest) (*ClientEntry, error) {
+	res, err := c.client.Post(ctx, "/api/clients", clientCreateRequest{
+		Client:  req.Client,
+		Comment: req.Comment,
+		Groups:  req.Groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList clientListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse client response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to create client %s: %w", req.Client, err)
+	}
+
+	return c.Get(ctx, req.Client)
+}
+
+// groupsUpdate omits nil groups from an update request while still sending an empty slice, which removes
+// every group assignment
+func groupsUpdate(groups []int) *[]int {
+	if groups == nil {
+		return nil
+	}
+
+	return &groups
+}
+
+// Update replaces the comment and group membership of a registered client
+func (c clients) Update(ctx context.Context, req ClientRequest) (*ClientEntry, error) {
+	res, err := c.client.Put(ctx, fmt.Sprintf("/api/clients/%s", url.PathEscape(req.Client)), clientUpdateRequest{
+		Comment: req.Comment,
+		Groups:  groupsUpdate(req.Groups),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorClientNotFound, req.Client)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList clientListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse client response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to update client %s: %w", req.Client, err)
+	}
+
+	return c.Get(ctx, req.Client)
+}
+
+// Delete removes a registered client
+func (c clients) Delete(ctx context.Context, client string) error {
+	res, err := c.client.Delete(ctx, fmt.Sprintf("/api/clients/%s", url.PathEscape(client)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrorClientNotFound, client)
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// Suggestions returns devices seen by Pi-hole that are not yet registered as clients
+func (c clients) Suggestions(ctx context.Context) (ClientSuggestionList, error) {
+	res, err := c.client.Get(ctx, "/api/clients/_suggestions")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList clientSuggestionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse client suggestions body: %w", err)
+	}
+
+	return resList.toClientSuggestionList(), nil
+}
@@ -0,0 +1,154 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cleanupClient(t *testing.T, c *Client, client string) {
+	if err := c.Clients.Delete(context.TODO(), client); err != nil {
+		log.Printf("Failed to clean up client: %s\n", client)
+	}
+}
+
+func TestClients(t *testing.T) {
+	t.Run("Test create a client", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		client := "10.11.12.13"
+
+		entry, err := c.Clients.Create(ctx, ClientRequest{
+			Client:  client,
+			Comment: "created",
+		})
+		require.NoError(t, err)
+		defer cleanupClient(t, c, client)
+
+		assert.Equal(t, client, entry.Client)
+		assert.Equal(t, "created", entry.Comment)
+
+		list, err := c.Clients.List(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, list, *entry)
+	})
+
+	t.Run("Test update client groups", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		client := "10.11.12.14"
+		groupName := fmt.Sprintf("test-%s", randomID())
+
+		group, err := c.Groups.Create(ctx, GroupRequest{Name: groupName, Enabled: true})
+		require.NoError(t, err)
+		defer cleanupGroup(t, c, groupName)
+
+		_, err = c.Clients.Create(ctx, ClientRequest{Client: client})
+		require.NoError(t, err)
+		defer cleanupClient(t, c, client)
+
+		entry, err := c.Clients.Update(ctx, ClientRequest{
+			Client:  client,
+			Comment: "updated",
+			Groups:  []int{0, group.ID},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "updated", entry.Comment)
+		assert.ElementsMatch(t, []int{0, group.ID}, entry.Groups)
+	})
+
+	t.Run("Test update without groups keeps the group membership", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				b, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"comment":"updated"}`, string(b))
+			}
+
+			fmt.Fprint(w, `{"clients":[{"client":"10.0.0.1","comment":"updated","groups":[0],"id":1}]}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		entry, err := c.Clients.Update(context.Background(), ClientRequest{Client: "10.0.0.1", Comment: "updated"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{0}, entry.Groups)
+	})
+
+	t.Run("Test update with empty groups removes every group", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				b, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"comment":"exempt","groups":[]}`, string(b))
+			}
+
+			fmt.Fprint(w, `{"clients":[{"client":"10.0.0.1","comment":"exempt","groups":[],"id":1}]}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		entry, err := c.Clients.Update(context.Background(), ClientRequest{Client: "10.0.0.1", Comment: "exempt", Groups: []int{}})
+		require.NoError(t, err)
+
+		assert.Empty(t, entry.Groups)
+	})
+
+	t.Run("Test delete a client", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		client := "10.11.12.15"
+
+		_, err := c.Clients.Create(ctx, ClientRequest{Client: client})
+		require.NoError(t, err)
+		defer cleanupClient(t, c, client)
+
+		err = c.Clients.Delete(ctx, client)
+		require.NoError(t, err)
+
+		_, err = c.Clients.Get(ctx, client)
+		assert.ErrorIs(t, err, ErrorClientNotFound)
+	})
+
+	t.Run("Test list client suggestions", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		_, err := c.Clients.Suggestions(context.Background())
+		require.NoError(t, err)
+	})
+}
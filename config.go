@@ -0,0 +1,272 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+type ConfigAPI interface {
+	// Get the full Pi-hole configuration.
+	Get(ctx context.Context) (*PiholeConfig, error)
+
+	// Patch applies a partial configuration tree, such as {"dns": {"queryLogging": false}}.
+	Patch(ctx context.Context, patch map[string]interface{}) (*PiholeConfig, error)
+
+	// Update patches the settings changed on a configuration returned by Get.
+	Update(ctx context.Context, config *PiholeConfig) (*PiholeConfig, error)
+}
+
+var (
+	ErrorConfigNotFetched = errors.New("config was not returned by Get")
+)
+
+type configAPI struct {
+	client *Client
+}
+
+// PiholeConfig is the Pi-hole configuration tree. Settings the library does not model are kept in the
+// raw tree returned by Raw and are left untouched by Update.
+type PiholeConfig struct {
+	DNS       DNSConfig       `json:"dns"`
+	DHCP      DHCPConfig      `json:"dhcp"`
+	Webserver WebserverConfig `json:"webserver"`
+	Misc      MiscConfig      `json:"misc"`
+
+	raw map[string]interface{}
+}
+
+type DNSConfig struct {
+	Upstreams           []string           `json:"upstreams"`
+	CNAMEDeepInspect    bool               `json:"CNAMEdeepInspect"`
+	BlockESNI           bool               `json:"blockESNI"`
+	EDNS0ECS            bool               `json:"EDNS0ECS"`
+	IgnoreLocalhost     bool               `json:"ignoreLocalhost"`
+	ShowDNSSEC          bool               `json:"showDNSSEC"`
+	AnalyzeOnlyAandAAAA bool               `json:"analyzeOnlyAandAAAA"`
+	PiholePTR           string             `json:"piholePTR"`
+	ReplyWhenBusy       string             `json:"replyWhenBusy"`
+	BlockTTL            int                `json:"blockTTL"`
+	Hosts               []string           `json:"hosts"`
+	DomainNeeded        bool               `json:"domainNeeded"`
+	ExpandHosts         bool               `json:"expandHosts"`
+	BogusPriv           bool               `json:"bogusPriv"`
+	DNSSEC              bool               `json:"dnssec"`
+	Interface           string             `json:"interface"`
+	HostRecord          string             `json:"hostRecord"`
+	ListeningMode       string             `json:"listeningMode"`
+	QueryLogging        bool               `json:"queryLogging"`
+	CNAMERecords        []string           `json:"cnameRecords"`
+	Port                int                `json:"port"`
+	RevServers          []string           `json:"revServers"`
+	Cache               DNSCacheConfig     `json:"cache"`
+	Blocking            DNSBlockingConfig  `json:"blocking"`
+	RateLimit           DNSRateLimitConfig `json:"rateLimit"`
+}
+
+type DNSCacheConfig struct {
+	Size               int `json:"size"`
+	Optimizer          int `json:"optimizer"`
+	UpstreamBlockedTTL int `json:"upstreamBlockedTTL"`
+}
+
+type DNSBlockingConfig struct {
+	Active bool   `json:"active"`
+	Mode   string `json:"mode"`
+}
+
+type DNSRateLimitConfig struct {
+	Count    int `json:"count"`
+	Interval int `json:"interval"`
+}
+
+type DHCPConfig struct {
+	Active               bool     `json:"active"`
+	Start                string   `json:"start"`
+	End                  string   `json:"end"`
+	Router               string   `json:"router"`
+	Netmask              string   `json:"netmask"`
+	LeaseTime            string   `json:"leaseTime"`
+	IPv6                 bool     `json:"ipv6"`
+	RapidCommit          bool     `json:"rapidCommit"`
+	MultiDNS             bool     `json:"multiDNS"`
+	Logging              bool     `json:"logging"`
+	IgnoreUnknownClients bool     `json:"ignoreUnknownClients"`
+	Hosts                []string `json:"hosts"`
+}
+
+type WebserverConfig struct {
+	Domain  string                 `json:"domain"`
+	Port    string                 `json:"port"`
+	Session WebserverSessionConfig `json:"session"`
+	API     WebserverAPIConfig     `json:"api"`
+}
+
+type WebserverSessionConfig struct {
+	Timeout int  `json:"timeout"`
+	Restore bool `json:"restore"`
+}
+
+type WebserverAPIConfig struct {
+	MaxSessions      int      `json:"max_sessions"`
+	PrettyJSON       bool     `json:"prettyJSON"`
+	AppSudo          bool     `json:"app_sudo"`
+	AllowDestructive bool     `json:"allow_destructive"`
+	ExcludeClients   []string `json:"excludeClients"`
+	ExcludeDomains   []string `json:"excludeDomains"`
+	MaxHistory       int      `json:"maxHistory"`
+	MaxClients       int      `json:"maxClients"`
+}
+
+type MiscConfig struct {
+	PrivacyLevel int      `json:"privacylevel"`
+	DelayStartup int      `json:"delay_startup"`
+	Nice         int      `json:"nice"`
+	EtcDnsmasqD  bool     `json:"etc_dnsmasq_d"`
+	DnsmasqLines []string `json:"dnsmasq_lines"`
+	ExtraLogging bool     `json:"extraLogging"`
+	ReadOnly     bool     `json:"readOnly"`
+}
+
+type configResponse struct {
+	Config json.RawMessage `json:"config"`
+}
+
+type configRequest struct {
+	Config map[string]interface{} `json:"config"`
+}
+
+// Raw returns the configuration tree as returned by Pi-hole, including settings that are not modeled.
+func (c *PiholeConfig) Raw() map[string]interface{} {
+	return c.raw
+}
+
+func (res configResponse) toPiholeConfig() (*PiholeConfig, error) {
+	var config PiholeConfig
+	if err := json.Unmarshal(res.Config, &config); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(res.Config, &config.raw); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// diffConfig returns the leaves of updated that differ from original. Keys the original tree does not
+// contain are skipped so settings unknown to the server are never sent.
+func diffConfig(original map[string]interface{}, updated map[string]interface{}) map[string]interface{} {
+	diff := map[string]interface{}{}
+
+	for key, value := range updated {
+		originalValue, ok := original[key]
+		if !ok {
+			continue
+		}
+
+		originalMap, originalIsMap := originalValue.(map[string]interface{})
+		valueMap, valueIsMap := value.(map[string]interface{})
+		if originalIsMap && valueIsMap {
+			if nested := diffConfig(originalMap, valueMap); len(nested) > 0 {
+				diff[key] = nested
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(originalValue, value) {
+			diff[key] = value
+		}
+	}
+
+	return diff
+}
+
+// toMap converts the modeled configuration to a generic tree matching the decoded raw tree
+func (c *PiholeConfig) toMap() (map[string]interface{}, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree map[string]interface{}
+	if err := json.Unmarshal(b, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+// Get returns the full Pi-hole configuration
+func (c configAPI) Get(ctx context.Context) (*PiholeConfig, error) {
+	res, err := c.client.Get(ctx, "/api/config")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var configRes configResponse
+	if err := json.NewDecoder(res.Body).Decode(&configRes); err != nil {
+		return nil, fmt.Errorf("failed to parse config body: %w", err)
+	}
+
+	config, err := configRes.toPiholeConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config body: %w", err)
+	}
+
+	return config, nil
+}
+
+// Patch applies a partial configuration tree, leaving every other setting unchanged
+func (c configAPI) Patch(ctx context.Context, patch map[string]interface{}) (*PiholeConfig, error) {
+	res, err := c.client.Patch(ctx, "/api/config", configRequest{Config: patch})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var configRes configResponse
+	if err := json.NewDecoder(res.Body).Decode(&configRes); err != nil {
+		return nil, fmt.Errorf("failed to parse config response body: %w", err)
+	}
+
+	config, err := configRes.toPiholeConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config response body: %w", err)
+	}
+
+	return config, nil
+}
+
+// Update patches the modeled settings that changed since the configuration was fetched. A configuration
+// that was not returned by Get is rejected, as its unset fields would overwrite the server settings.
+func (c configAPI) Update(ctx context.Context, config *PiholeConfig) (*PiholeConfig, error) {
+	if config.raw == nil {
+		return nil, fmt.Errorf("%w: fetch the config with Get first or use Patch for partial changes", ErrorConfigNotFetched)
+	}
+
+	tree, err := config.toMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	tree = diffConfig(config.raw, tree)
+
+	if len(tree) == 0 {
+		return config, nil
+	}
+
+	return c.Patch(ctx, tree)
+}
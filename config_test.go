@@ -0,0 +1,113 @@
+package pihole
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigUpdateRequiresGet(t *testing.T) {
+	t.Run("Test rejects a config that was not fetched", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		c, err := New(Config{BaseURL: "http://localhost:8080", SessionID: "test"})
+		require.NoError(t, err)
+
+		_, err = c.ConfigAPI.Update(context.Background(), &PiholeConfig{DNS: DNSConfig{BlockTTL: 5}})
+		assert.ErrorIs(t, err, ErrorConfigNotFetched)
+	})
+}
+
+func TestDiffConfig(t *testing.T) {
+	tcs := []struct {
+		name     string
+		original map[string]interface{}
+		updated  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "unchanged",
+			original: map[string]interface{}{
+				"dns": map[string]interface{}{"blockTTL": float64(2)},
+			},
+			updated: map[string]interface{}{
+				"dns": map[string]interface{}{"blockTTL": float64(2)},
+			},
+			expected: map[string]interface{}{},
+		},
+		{
+			name: "changed leaves only",
+			original: map[string]interface{}{
+				"dns": map[string]interface{}{
+					"blockTTL":  float64(2),
+					"upstreams": []interface{}{"8.8.8.8"},
+					"cache":     map[string]interface{}{"size": float64(10000)},
+				},
+			},
+			updated: map[string]interface{}{
+				"dns": map[string]interface{}{
+					"blockTTL":  float64(2),
+					"upstreams": []interface{}{"1.1.1.1"},
+					"cache":     map[string]interface{}{"size": float64(10000)},
+				},
+			},
+			expected: map[string]interface{}{
+				"dns": map[string]interface{}{"upstreams": []interface{}{"1.1.1.1"}},
+			},
+		},
+		{
+			name: "keys unknown to the server are skipped",
+			original: map[string]interface{}{
+				"dns": map[string]interface{}{"blockTTL": float64(2)},
+			},
+			updated: map[string]interface{}{
+				"dns": map[string]interface{}{"blockTTL": float64(2), "newSetting": true},
+			},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			isUnit(t)
+
+			assert.Equal(t, tc.expected, diffConfig(tc.original, tc.updated))
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	t.Run("Test update config", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		config, err := c.ConfigAPI.Get(ctx)
+		require.NoError(t, err)
+		require.Contains(t, config.Raw(), "ntp")
+
+		original := config.DNS.BlockTTL
+		defer func() {
+			if _, err := c.ConfigAPI.Patch(ctx, map[string]interface{}{
+				"dns": map[string]interface{}{"blockTTL": original},
+			}); err != nil {
+				log.Printf("Failed to restore dns.blockTTL: %s\n", err)
+			}
+		}()
+
+		config.DNS.BlockTTL = original + 1
+
+		updated, err := c.ConfigAPI.Update(ctx, config)
+		require.NoError(t, err)
+
+		assert.Equal(t, original+1, updated.DNS.BlockTTL)
+		assert.Equal(t, config.DNS.Upstreams, updated.DNS.Upstreams)
+	})
+}
@@ -0,0 +1,280 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type DHCP interface {
+	// ListLeases returns all active DHCP leases.
+	ListLeases(ctx context.Context) (LeaseList, error)
+
+	// DeleteLease removes the DHCP lease of an IP address.
+	DeleteLease(ctx context.Context, IP string) error
+
+	// ListHosts returns all static DHCP host reservations.
+	ListHosts(ctx context.Context) (DHCPHostList, error)
+
+	// CreateHost creates a static DHCP host reservation.
+	CreateHost(ctx context.Context, hwaddr string, IP string, hostname string) (*DHCPHost, error)
+
+	// GetHost returns a static DHCP host reservation by its hardware address.
+	GetHost(ctx context.Context, hwaddr string) (*DHCPHost, error)
+
+	// DeleteHost removes a static DHCP host reservation by its hardware address.
+	DeleteHost(ctx context.Context, hwaddr string) error
+}
+
+var (
+	ErrorDHCPLeaseNotFound = errors.New("dhcp lease not found")
+	ErrorDHCPHostNotFound  = errors.New("dhcp host not found")
+)
+
+type dhcp struct {
+	client *Client
+}
+
+// Lease is an active DHCP lease. A zero Expires means the lease does not expire.
+type Lease struct {
+	Expires  time.Time
+	Name     string
+	HWAddr   string
+	IP       string
+	ClientID string
+}
+
+type LeaseList []Lease
+
+// DHCPHost is a static DHCP host reservation
+type DHCPHost struct {
+	HWAddr    string
+	IP        string
+	Hostname  string
+	LeaseTime string
+
+	// entry is the dnsmasq dhcp-host value the reservation was parsed from
+	entry string
+}
+
+type DHCPHostList []DHCPHost
+
+type leaseListResponse struct {
+	Leases []struct {
+		Expires  int64   `json:"expires"`
+		Name     *string `json:"name"`
+		HWAddr   string  `json:"hwaddr"`
+		IP       string  `json:"ip"`
+		ClientID *string `json:"clientid"`
+	} `json:"leases"`
+}
+
+type dhcpHostListResponse struct {
+	Config struct {
+		DHCP struct {
+			Hosts []string `json:"hosts"`
+		} `json:"dhcp"`
+	} `json:"config"`
+}
+
+func (res leaseListResponse) toLeaseList() LeaseList {
+	list := make(LeaseList, len(res.Leases))
+
+	for i, l := range res.Leases {
+		lease := Lease{
+			Name:     stringValue(l.Name),
+			HWAddr:   l.HWAddr,
+			IP:       l.IP,
+			ClientID: stringValue(l.ClientID),
+		}
+
+		if l.Expires != 0 {
+			lease.Expires = time.Unix(l.Expires, 0)
+		}
+
+		list[i] = lease
+	}
+
+	return list
+}
+
+// parseDHCPHost reads the hardware address, IP, hostname and lease time from a dnsmasq dhcp-host value
+func parseDHCPHost(entry string) DHCPHost {
+	host := DHCPHost{entry: entry}
+
+	for _, field := range strings.Split(entry, ",") {
+		field = strings.TrimSpace(field)
+
+		switch {
+		case field == "" || field == "ignore" || strings.HasPrefix(field, "id:") || strings.HasPrefix(field, "set:") || strings.HasPrefix(field, "tag:"):
+			continue
+		case host.HWAddr == "" && isHWAddr(field):
+			host.HWAddr = field
+		case host.IP == "" && net.ParseIP(strings.Trim(field, "[]")) != nil:
+			host.IP = strings.Trim(field, "[]")
+		case field == "infinite" || isLeaseTime(field):
+			host.LeaseTime = field
+		case host.Hostname == "":
+			host.Hostname = field
+		}
+	}
+
+	return host
+}
+
+func isHWAddr(value string) bool {
+	_, err := net.ParseMAC(value)
+	return err == nil
+}
+
+// isLeaseTime reports whether a value is a dnsmasq lease time such as 3600, 45m, 12h or 1d
+func isLeaseTime(value string) bool {
+	digits := strings.TrimRight(value, "smhdw")
+	if digits == "" || len(value)-len(digits) > 1 {
+		return false
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ListLeases returns all active DHCP leases
+func (d dhcp) ListLeases(ctx context.Context) (LeaseList, error) {
+	res, err := d.client.Get(ctx, "/api/dhcp/leases")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList leaseListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse DHCP lease list body: %w", err)
+	}
+
+	return resList.toLeaseList(), nil
+}
+
+// DeleteLease removes the DHCP lease of an IP address
+func (d dhcp) DeleteLease(ctx context.Context, IP string) error {
+	res, err := d.client.Delete(ctx, fmt.Sprintf("/api/dhcp/leases/%s", url.PathEscape(IP)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrorDHCPLeaseNotFound, IP)
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// ListHosts returns all static DHCP host reservations
+func (d dhcp) ListHosts(ctx context.Context) (DHCPHostList, error) {
+	res, err := d.client.Get(ctx, "/api/config/dhcp/hosts")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList dhcpHostListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse DHCP host list body: %w", err)
+	}
+
+	list := make(DHCPHostList, len(resList.Config.DHCP.Hosts))
+	for i, entry := range resList.Config.DHCP.Hosts {
+		list[i] = parseDHCPHost(entry)
+	}
+
+	return list, nil
+}
+
+// CreateHost creates a static DHCP host reservation
+func (d dhcp) CreateHost(ctx context.Context, hwaddr string, IP string, hostname string) (*DHCPHost, error) {
+	// dnsmasq requires IPv6 addresses in brackets to tell them apart from hardware addresses
+	if ip := net.ParseIP(IP); ip != nil && ip.To4() == nil {
+		IP = fmt.Sprintf("[%s]", IP)
+	}
+
+	fields := []string{hwaddr, IP}
+	if hostname != "" {
+		fields = append(fields, hostname)
+	}
+
+	value := strings.Join(fields, ",")
+
+	res, err := d.client.Put(ctx, fmt.Sprintf("/api/config/dhcp/hosts/%s", url.PathEscape(value)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	return d.GetHost(ctx, hwaddr)
+}
+
+// GetHost returns a static DHCP host reservation by its hardware address
+func (d dhcp) GetHost(ctx context.Context, hwaddr string) (*DHCPHost, error) {
+	hosts, err := d.ListHosts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch DHCP hosts: %w", err)
+	}
+
+	for _, host := range hosts {
+		if strings.EqualFold(host.HWAddr, hwaddr) {
+			return &host, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrorDHCPHostNotFound, hwaddr)
+}
+
+// DeleteHost removes a static DHCP host reservation by its hardware address
+func (d dhcp) DeleteHost(ctx context.Context, hwaddr string) error {
+	host, err := d.GetHost(ctx, hwaddr)
+	if err != nil {
+		if errors.Is(err, ErrorDHCPHostNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed looking up DHCP host %s for deletion: %w", hwaddr, err)
+	}
+
+	res, err := d.client.Delete(ctx, fmt.Sprintf("/api/config/dhcp/hosts/%s", url.PathEscape(host.entry)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return unexpectedStatus(res)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package pihole
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDHCPHost(t *testing.T) {
+	tcs := []struct {
+		entry    string
+		expected DHCPHost
+	}{
+		{
+			entry:    "00:11:22:33:44:55,192.168.1.10,printer",
+			expected: DHCPHost{HWAddr: "00:11:22:33:44:55", IP: "192.168.1.10", Hostname: "printer"},
+		},
+		{
+			entry:    "00:11:22:33:44:55,192.168.1.10,printer,infinite",
+			expected: DHCPHost{HWAddr: "00:11:22:33:44:55", IP: "192.168.1.10", Hostname: "printer", LeaseTime: "infinite"},
+		},
+		{
+			entry:    "aa:bb:cc:dd:ee:ff,set:iot,[fd00::10],12h",
+			expected: DHCPHost{HWAddr: "aa:bb:cc:dd:ee:ff", IP: "fd00::10", LeaseTime: "12h"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.entry, func(t *testing.T) {
+			isUnit(t)
+
+			tc.expected.entry = tc.entry
+			assert.Equal(t, tc.expected, parseDHCPHost(tc.entry))
+		})
+	}
+}
+
+func cleanupDHCPHost(t *testing.T, c *Client, hwaddr string) {
+	if err := c.DHCP.DeleteHost(context.TODO(), hwaddr); err != nil {
+		log.Printf("Failed to clean up DHCP host: %s\n", hwaddr)
+	}
+}
+
+func TestDHCP(t *testing.T) {
+	t.Run("Test list DHCP leases", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		_, err := c.DHCP.ListLeases(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Test create a DHCP host", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		hwaddr := "02:00:00:00:00:01"
+
+		host, err := c.DHCP.CreateHost(ctx, hwaddr, "192.168.1.201", "test-host")
+		require.NoError(t, err)
+		defer cleanupDHCPHost(t, c, hwaddr)
+
+		assert.Equal(t, hwaddr, host.HWAddr)
+		assert.Equal(t, "192.168.1.201", host.IP)
+		assert.Equal(t, "test-host", host.Hostname)
+	})
+
+	t.Run("Test delete a DHCP host", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		hwaddr := "02:00:00:00:00:02"
+
+		_, err := c.DHCP.CreateHost(ctx, hwaddr, "192.168.1.202", "")
+		require.NoError(t, err)
+		defer cleanupDHCPHost(t, c, hwaddr)
+
+		err = c.DHCP.DeleteHost(ctx, hwaddr)
+		require.NoError(t, err)
+
+		_, err = c.DHCP.GetHost(ctx, hwaddr)
+		assert.ErrorIs(t, err, ErrorDHCPHostNotFound)
+	})
+}
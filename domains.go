@@ -0,0 +1,388 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Domains interface {
+	// List all domains of the given type and kind.
+	List(ctx context.Context, domainType DomainType, kind DomainKind) (DomainList, error)
+
+	// Get a domain by its type, kind and name.
+	Get(ctx context.Context, domainType DomainType, kind DomainKind, domain string) (*Domain, error)
+
+	// Create a domain.
+	Create(ctx context.Context, req DomainRequest) (*Domain, error)
+
+	// Update a domain identified by the request domain, type and kind.
+	Update(ctx context.Context, req DomainRequest) (*Domain, error)
+
+	// Delete a domain by its type, kind and name.
+	Delete(ctx context.Context, domainType DomainType, kind DomainKind, domain string) error
+
+	// Search the allow, deny and gravity lists for a domain.
+	Search(ctx context.Context, domain string, opts SearchOptions) (*SearchResult, error)
+}
+
+var (
+	ErrorDomainNotFound = errors.New("domain not found")
+)
+
+// DomainType is the list a domain belongs to
+type DomainType string
+
+const (
+	DomainTypeAllow DomainType = "allow"
+	DomainTypeDeny  DomainType = "deny"
+)
+
+// DomainKind describes how a domain is matched against queries
+type DomainKind string
+
+const (
+	DomainKindExact DomainKind = "exact"
+	DomainKindRegex DomainKind = "regex"
+)
+
+type domains struct {
+	client *Client
+}
+
+type Domain struct {
+	ID           int
+	Domain       string
+	Unicode      string
+	Type         DomainType
+	Kind         DomainKind
+	Comment      string
+	Groups       []int
+	Enabled      bool
+	DateAdded    time.Time
+	DateModified time.Time
+}
+
+type DomainList []Domain
+
+// DomainRequest describes a domain to create or update. Enabled must be set for the domain to take effect.
+// On update, nil Groups keep the domain's groups while an empty slice unassigns it from all of them.
+type DomainRequest struct {
+	Domain  string
+	Type    DomainType
+	Kind    DomainKind
+	Comment string
+	Groups  []int
+	Enabled bool
+}
+
+// SearchOptions controls how a domain search is matched. Partial matches any domain containing the search
+// term and Limit caps the number of results of each list type.
+type SearchOptions struct {
+	Partial bool
+	Limit   int
+}
+
+// SearchResult explains why a domain is allowed or blocked. Domains holds the matching exact and regex
+// entries, Gravity the subscription lists containing the domain, and both carry the IDs of the groups
+// they apply to.
+type SearchResult struct {
+	Domains      DomainList
+	Gravity      []GravityMatch
+	ExactMatches int
+	RegexMatches int
+	GravityAllow int
+	GravityBlock int
+	Total        int
+}
+
+// GravityMatch is a domain found in a subscription list
+type GravityMatch struct {
+	Domain string
+	List   Subscription
+}
+
+type domainListResponse struct {
+	Domains   []domainResponse   `json:"domains"`
+	Processed *processedResponse `json:"processed"`
+}
+
+type domainResponse struct {
+	ID           int        `json:"id"`
+	Domain       string     `json:"domain"`
+	Unicode      string     `json:"unicode"`
+	Type         DomainType `json:"type"`
+	Kind         DomainKind `json:"kind"`
+	Comment      *string    `json:"comment"`
+	Groups       []int      `json:"groups"`
+	Enabled      bool       `json:"enabled"`
+	DateAdded    int64      `json:"date_added"`
+	DateModified int64      `json:"date_modified"`
+}
+
+type searchResponse struct {
+	Search struct {
+		Domains []domainResponse       `json:"domains"`
+		Gravity []gravityMatchResponse `json:"gravity"`
+		Results struct {
+			Domains struct {
+				Exact int `json:"exact"`
+				Regex int `json:"regex"`
+			} `json:"domains"`
+			Gravity struct {
+				Allow int `json:"allow"`
+				Block int `json:"block"`
+			} `json:"gravity"`
+			Total int `json:"total"`
+		} `json:"results"`
+	} `json:"search"`
+}
+
+type gravityMatchResponse struct {
+	subscriptionResponse
+	Domain string `json:"domain"`
+}
+
+type domainCreateRequest struct {
+	Domain  string `json:"domain"`
+	Comment string `json:"comment"`
+	Groups  []int  `json:"groups,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+type domainUpdateRequest struct {
+	Type    DomainType `json:"type"`
+	Kind    DomainKind `json:"kind"`
+	Comment string     `json:"comment"`
+	Groups  *[]int     `json:"groups,omitempty"`
+	Enabled bool       `json:"enabled"`
+}
+
+func (res domainResponse) toDomain() Domain {
+	d := Domain{
+		ID:           res.ID,
+		Domain:       res.Domain,
+		Unicode:      res.Unicode,
+		Type:         res.Type,
+		Kind:         res.Kind,
+		Groups:       res.Groups,
+		Enabled:      res.Enabled,
+		DateAdded:    time.Unix(res.DateAdded, 0),
+		DateModified: time.Unix(res.DateModified, 0),
+	}
+
+	if res.Comment != nil {
+		d.Comment = *res.Comment
+	}
+
+	return d
+}
+
+func (res domainListResponse) toDomainList() DomainList {
+	list := make(DomainList, len(res.Domains))
+
+	for i, d := range res.Domains {
+		list[i] = d.toDomain()
+	}
+
+	return list
+}
+
+func (res searchResponse) toSearchResult() *SearchResult {
+	result := &SearchResult{
+		Domains:      domainListResponse{Domains: res.Search.Domains}.toDomainList(),
+		Gravity:      make([]GravityMatch, len(res.Search.Gravity)),
+		ExactMatches: res.Search.Results.Domains.Exact,
+		RegexMatches: res.Search.Results.Domains.Regex,
+		GravityAllow: res.Search.Results.Gravity.Allow,
+		GravityBlock: res.Search.Results.Gravity.Block,
+		Total:        res.Search.Results.Total,
+	}
+
+	for i, match := range res.Search.Gravity {
+		result.Gravity[i] = GravityMatch{
+			Domain: match.Domain,
+			List:   match.toSubscription(),
+		}
+	}
+
+	return result
+}
+
+func domainPath(domainType DomainType, kind DomainKind, domain string) string {
+	path := fmt.Sprintf("/api/domains/%s/%s", domainType, kind)
+	if domain != "" {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(domain))
+	}
+
+	return path
+}
+
+// List returns the domains of a type and kind
+func (d domains) List(ctx context.Context, domainType DomainType, kind DomainKind) (DomainList, error) {
+	res, err := d.client.Get(ctx, domainPath(domainType, kind, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList domainListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse domain list body: %w", err)
+	}
+
+	return resList.toDomainList(), nil
+}
+
+// Get returns a domain by its type, kind and name
+func (d domains) Get(ctx context.Context, domainType DomainType, kind DomainKind, domain string) (*Domain, error) {
+	res, err := d.client.Get(ctx, domainPath(domainType, kind, domain))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorDomainNotFound, domain)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList domainListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse domain body: %w", err)
+	}
+
+	if len(resList.Domains) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorDomainNotFound, domain)
+	}
+
+	record := resList.Domains[0].toDomain()
+
+	return &record, nil
+}
+
+// Create adds a domain to an allow or deny list
+func (d domains) Create(ctx context.Context, req DomainRequest) (*Domain, error) {
+	res, err := d.client.Post(ctx, domainPath(req.Type, req.Kind, ""), domainCreateRequest{
+		Domain:  req.Domain,
+		Comment: req.Comment,
+		Groups:  req.Groups,
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList domainListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse domain response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to create domain %s: %w", req.Domain, err)
+	}
+
+	return d.Get(ctx, req.Type, req.Kind, req.Domain)
+}
+
+// Update replaces the comment, groups and enabled state of a domain
+func (d domains) Update(ctx context.Context, req DomainRequest) (*Domain, error) {
+	res, err := d.client.Put(ctx, domainPath(req.Type, req.Kind, req.Domain), domainUpdateRequest{
+		Type:    req.Type,
+		Kind:    req.Kind,
+		Comment: req.Comment,
+		Groups:  groupsUpdate(req.Groups),
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorDomainNotFound, req.Domain)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList domainListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse domain response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to update domain %s: %w", req.Domain, err)
+	}
+
+	return d.Get(ctx, req.Type, req.Kind, req.Domain)
+}
+
+// Delete removes a domain from an allow or deny list
+func (d domains) Delete(ctx context.Context, domainType DomainType, kind DomainKind, domain string) error {
+	res, err := d.client.Delete(ctx, domainPath(domainType, kind, domain))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrorDomainNotFound, domain)
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// Search looks up a domain across the allow, deny and gravity lists
+func (d domains) Search(ctx context.Context, domain string, opts SearchOptions) (*SearchResult, error) {
+	vals := url.Values{}
+	if opts.Partial {
+		vals.Set("partial", "true")
+	}
+	if opts.Limit != 0 {
+		vals.Set("N", strconv.Itoa(opts.Limit))
+	}
+
+	path := fmt.Sprintf("/api/search/%s", url.PathEscape(domain))
+	if len(vals) > 0 {
+		path = fmt.Sprintf("%s?%s", path, vals.Encode())
+	}
+
+	res, err := d.client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var searchRes searchResponse
+	if err := json.NewDecoder(res.Body).Decode(&searchRes); err != nil {
+		return nil, fmt.Errorf("failed to parse search body: %w", err)
+	}
+
+	return searchRes.toSearchResult(), nil
+}
@@ -0,0 +1,175 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cleanupDomain(t *testing.T, c *Client, domainType DomainType, kind DomainKind, domain string) {
+	if err := c.Domains.Delete(context.TODO(), domainType, kind, domain); err != nil {
+		log.Printf("Failed to clean up domain: %s\n", domain)
+	}
+}
+
+func TestDomains(t *testing.T) {
+	tcs := []struct {
+		name       string
+		domainType DomainType
+		kind       DomainKind
+		domain     string
+	}{
+		{
+			name:       "allow exact",
+			domainType: DomainTypeAllow,
+			kind:       DomainKindExact,
+			domain:     fmt.Sprintf("allow.%s.com", randomID()),
+		},
+		{
+			name:       "deny regex",
+			domainType: DomainTypeDeny,
+			kind:       DomainKindRegex,
+			domain:     fmt.Sprintf(`(^|\.)%s\.com$`, randomID()),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(fmt.Sprintf("Test create, update and delete %s domain", tc.name), func(t *testing.T) {
+			isAcceptance(t)
+
+			c := newTestClient(t)
+			defer cleanupTestClient(c)
+
+			ctx := context.Background()
+
+			domain, err := c.Domains.Create(ctx, DomainRequest{
+				Domain:  tc.domain,
+				Type:    tc.domainType,
+				Kind:    tc.kind,
+				Comment: "created",
+				Enabled: true,
+			})
+			require.NoError(t, err)
+			defer cleanupDomain(t, c, tc.domainType, tc.kind, tc.domain)
+
+			assert.Equal(t, tc.domain, domain.Domain)
+			assert.Equal(t, "created", domain.Comment)
+			assert.True(t, domain.Enabled)
+
+			domain, err = c.Domains.Update(ctx, DomainRequest{
+				Domain:  tc.domain,
+				Type:    tc.domainType,
+				Kind:    tc.kind,
+				Comment: "updated",
+				Enabled: false,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, "updated", domain.Comment)
+			assert.False(t, domain.Enabled)
+
+			list, err := c.Domains.List(ctx, tc.domainType, tc.kind)
+			require.NoError(t, err)
+			assert.Contains(t, list, *domain)
+
+			err = c.Domains.Delete(ctx, tc.domainType, tc.kind, tc.domain)
+			require.NoError(t, err)
+
+			_, err = c.Domains.Get(ctx, tc.domainType, tc.kind, tc.domain)
+			assert.ErrorIs(t, err, ErrorDomainNotFound)
+		})
+	}
+}
+
+func TestDomainsUpdateGroups(t *testing.T) {
+	t.Run("Test update with empty groups unassigns every group", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				b, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"type":"deny","kind":"exact","comment":"","groups":[],"enabled":true}`, string(b))
+			}
+
+			fmt.Fprint(w, `{"domains":[{"domain":"ads.example.com","type":"deny","kind":"exact","groups":[],"enabled":true,"id":1}]}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		domain, err := c.Domains.Update(context.Background(), DomainRequest{
+			Domain:  "ads.example.com",
+			Type:    DomainTypeDeny,
+			Kind:    DomainKindExact,
+			Groups:  []int{},
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, domain.Groups)
+	})
+}
+
+func TestDomainsSearch(t *testing.T) {
+	t.Run("Test search parses gravity matches", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/search/ads.example.com", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("partial"))
+
+			fmt.Fprint(w, `{"search":{"domains":[],"gravity":[{"domain":"ads.example.com","address":"https://lists.example.com/ads.txt","type":"block","groups":[0,2],"enabled":true,"id":3}],"results":{"domains":{"exact":0,"regex":0},"gravity":{"allow":0,"block":1},"total":1}}}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		result, err := c.Domains.Search(context.Background(), "ads.example.com", SearchOptions{Partial: true})
+		require.NoError(t, err)
+
+		require.Len(t, result.Gravity, 1)
+		assert.Equal(t, "ads.example.com", result.Gravity[0].Domain)
+		assert.Equal(t, "https://lists.example.com/ads.txt", result.Gravity[0].List.Address)
+		assert.Equal(t, []int{0, 2}, result.Gravity[0].List.Groups)
+		assert.Equal(t, 1, result.GravityBlock)
+	})
+
+	t.Run("Test search finds exact and regex matches", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		id := randomID()
+		domain := fmt.Sprintf("search.%s.com", id)
+		regex := fmt.Sprintf(`(^|\.)%s\.com$`, id)
+
+		_, err := c.Domains.Create(ctx, DomainRequest{Domain: domain, Type: DomainTypeDeny, Kind: DomainKindExact, Enabled: true})
+		require.NoError(t, err)
+		defer cleanupDomain(t, c, DomainTypeDeny, DomainKindExact, domain)
+
+		_, err = c.Domains.Create(ctx, DomainRequest{Domain: regex, Type: DomainTypeDeny, Kind: DomainKindRegex, Enabled: true})
+		require.NoError(t, err)
+		defer cleanupDomain(t, c, DomainTypeDeny, DomainKindRegex, regex)
+
+		result, err := c.Domains.Search(ctx, domain, SearchOptions{Limit: 10})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, result.ExactMatches)
+		assert.Equal(t, 1, result.RegexMatches)
+		assert.Len(t, result.Domains, 2)
+	})
+}
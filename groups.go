@@ -0,0 +1,285 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type Groups interface {
+	// List all groups.
+	List(ctx context.Context) (GroupList, error)
+
+	// Get a group by its name.
+	Get(ctx context.Context, name string) (*Group, error)
+
+	// Create a group.
+	Create(ctx context.Context, req GroupRequest) (*Group, error)
+
+	// Rename a group.
+	Rename(ctx context.Context, name string, newName string) (*Group, error)
+
+	// Enable a group.
+	Enable(ctx context.Context, name string) (*Group, error)
+
+	// Disable a group.
+	Disable(ctx context.Context, name string) (*Group, error)
+
+	// SetComment replaces the comment of a group.
+	SetComment(ctx context.Context, name string, comment string) (*Group, error)
+
+	// Delete a group by its name.
+	Delete(ctx context.Context, name string) error
+}
+
+var (
+	ErrorGroupNotFound = errors.New("group not found")
+)
+
+type groups struct {
+	client *Client
+}
+
+type Group struct {
+	ID           int
+	Name         string
+	Comment      string
+	Enabled      bool
+	DateAdded    time.Time
+	DateModified time.Time
+}
+
+type GroupList []Group
+
+// GroupRequest describes a group to create. Enabled must be set for the group to take effect.
+type GroupRequest struct {
+	Name    string
+	Comment string
+	Enabled bool
+}
+
+type groupListResponse struct {
+	Groups    []groupResponse    `json:"groups"`
+	Processed *processedResponse `json:"processed"`
+}
+
+type groupResponse struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Comment      *string `json:"comment"`
+	Enabled      bool    `json:"enabled"`
+	DateAdded    int64   `json:"date_added"`
+	DateModified int64   `json:"date_modified"`
+}
+
+type groupRequest struct {
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (res groupResponse) toGroup() Group {
+	g := Group{
+		ID:           res.ID,
+		Name:         res.Name,
+		Enabled:      res.Enabled,
+		DateAdded:    time.Unix(res.DateAdded, 0),
+		DateModified: time.Unix(res.DateModified, 0),
+	}
+
+	if res.Comment != nil {
+		g.Comment = *res.Comment
+	}
+
+	return g
+}
+
+func (res groupListResponse) toGroupList() GroupList {
+	list := make(GroupList, len(res.Groups))
+
+	for i, g := range res.Groups {
+		list[i] = g.toGroup()
+	}
+
+	return list
+}
+
+// List returns all groups
+func (g groups) List(ctx context.Context) (GroupList, error) {
+	res, err := g.client.Get(ctx, "/api/groups")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList groupListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse group list body: %w", err)
+	}
+
+	return resList.toGroupList(), nil
+}
+
+// Get returns a group by its name
+func (g groups) Get(ctx context.Context, name string) (*Group, error) {
+	res, err := g.client.Get(ctx, fmt.Sprintf("/api/groups/%s", url.PathEscape(name)))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorGroupNotFound, name)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList groupListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse group body: %w", err)
+	}
+
+	if len(resList.Groups) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorGroupNotFound, name)
+	}
+
+	group := resList.Groups[0].toGroup()
+
+	return &group, nil
+}
+
+// Create creates a group
+func (g groups) Create(ctx context.Context, req GroupRequest) (*Group, error) {
+	res, err := g.client.Post(ctx, "/api/groups", groupRequest{
+		Name:    req.Name,
+		Comment: req.Comment,
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList groupListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse group response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to create group %s: %w", req.Name, err)
+	}
+
+	return g.Get(ctx, req.Name)
+}
+
+// Rename changes the name of a group
+func (g groups) Rename(ctx context.Context, name string, newName string) (*Group, error) {
+	group, err := g.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Name = newName
+
+	return g.update(ctx, name, group)
+}
+
+// Enable enables a group
+func (g groups) Enable(ctx context.Context, name string) (*Group, error) {
+	group, err := g.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Enabled = true
+
+	return g.update(ctx, name, group)
+}
+
+// Disable disables a group
+func (g groups) Disable(ctx context.Context, name string) (*Group, error) {
+	group, err := g.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Enabled = false
+
+	return g.update(ctx, name, group)
+}
+
+// SetComment replaces the comment of a group
+func (g groups) SetComment(ctx context.Context, name string, comment string) (*Group, error) {
+	group, err := g.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Comment = comment
+
+	return g.update(ctx, name, group)
+}
+
+// update replaces the group stored under name with the passed group
+func (g groups) update(ctx context.Context, name string, group *Group) (*Group, error) {
+	res, err := g.client.Put(ctx, fmt.Sprintf("/api/groups/%s", url.PathEscape(name)), groupRequest{
+		Name:    group.Name,
+		Comment: group.Comment,
+		Enabled: group.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorGroupNotFound, name)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList groupListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse group response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to update group %s: %w", name, err)
+	}
+
+	return g.Get(ctx, group.Name)
+}
+
+// Delete removes a group by its name
+func (g groups) Delete(ctx context.Context, name string) error {
+	res, err := g.client.Delete(ctx, fmt.Sprintf("/api/groups/%s", url.PathEscape(name)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrorGroupNotFound, name)
+	default:
+		return unexpectedStatus(res)
+	}
+}
@@ -0,0 +1,102 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cleanupGroup(t *testing.T, c *Client, name string) {
+	if err := c.Groups.Delete(context.TODO(), name); err != nil {
+		log.Printf("Failed to clean up group: %s\n", name)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	t.Run("Test create a group", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		name := fmt.Sprintf("test-%s", randomID())
+
+		group, err := c.Groups.Create(ctx, GroupRequest{
+			Name:    name,
+			Comment: "created",
+			Enabled: true,
+		})
+		require.NoError(t, err)
+		defer cleanupGroup(t, c, name)
+
+		assert.Equal(t, name, group.Name)
+		assert.Equal(t, "created", group.Comment)
+		assert.True(t, group.Enabled)
+
+		list, err := c.Groups.List(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, list, *group)
+	})
+
+	t.Run("Test update a group", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		name := fmt.Sprintf("test-%s", randomID())
+		newName := fmt.Sprintf("test-%s", randomID())
+
+		_, err := c.Groups.Create(ctx, GroupRequest{Name: name, Enabled: true})
+		require.NoError(t, err)
+		defer cleanupGroup(t, c, name)
+
+		group, err := c.Groups.Disable(ctx, name)
+		require.NoError(t, err)
+		assert.False(t, group.Enabled)
+
+		group, err = c.Groups.SetComment(ctx, name, "updated")
+		require.NoError(t, err)
+		assert.Equal(t, "updated", group.Comment)
+
+		group, err = c.Groups.Rename(ctx, name, newName)
+		require.NoError(t, err)
+		defer cleanupGroup(t, c, newName)
+
+		assert.Equal(t, newName, group.Name)
+		assert.Equal(t, "updated", group.Comment)
+		assert.False(t, group.Enabled)
+
+		_, err = c.Groups.Get(ctx, name)
+		assert.ErrorIs(t, err, ErrorGroupNotFound)
+	})
+
+	t.Run("Test delete a group", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		name := fmt.Sprintf("test-%s", randomID())
+
+		_, err := c.Groups.Create(ctx, GroupRequest{Name: name, Enabled: true})
+		require.NoError(t, err)
+		defer cleanupGroup(t, c, name)
+
+		err = c.Groups.Delete(ctx, name)
+		require.NoError(t, err)
+
+		_, err = c.Groups.Get(ctx, name)
+		assert.ErrorIs(t, err, ErrorGroupNotFound)
+	})
+}
@@ -0,0 +1,159 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type History interface {
+	// Get the query volume over time.
+	Get(ctx context.Context, opts HistoryOptions) ([]HistoryBucket, error)
+
+	// Clients returns the query volume over time per client.
+	Clients(ctx context.Context, opts HistoryOptions) (*ClientHistory, error)
+}
+
+type history struct {
+	client *Client
+}
+
+// HistoryOptions narrows history requests. Setting From or Until reads the history from the long-term
+// database rather than memory. A zero From starts at the oldest record and a zero Until ends now.
+type HistoryOptions struct {
+	From  time.Time
+	Until time.Time
+
+	// Clients limits the number of clients returned by the in-memory client history.
+	Clients int
+}
+
+type HistoryBucket struct {
+	Timestamp time.Time
+	Total     int
+	Cached    int
+	Blocked   int
+	Forwarded int
+}
+
+// ClientHistory holds per client query counts for each time bucket, keyed by client IP
+type ClientHistory struct {
+	Clients map[string]HistoryClient
+	Buckets []ClientHistoryBucket
+}
+
+type HistoryClient struct {
+	Name  string
+	Total int
+}
+
+type ClientHistoryBucket struct {
+	Timestamp time.Time
+	Counts    map[string]int
+}
+
+type historyResponse struct {
+	History []struct {
+		Timestamp float64 `json:"timestamp"`
+		Total     int     `json:"total"`
+		Cached    int     `json:"cached"`
+		Blocked   int     `json:"blocked"`
+		Forwarded int     `json:"forwarded"`
+	} `json:"history"`
+}
+
+type clientHistoryResponse struct {
+	Clients map[string]struct {
+		Name  *string `json:"name"`
+		Total int     `json:"total"`
+	} `json:"clients"`
+	History []struct {
+		Timestamp float64        `json:"timestamp"`
+		Data      map[string]int `json:"data"`
+	} `json:"history"`
+}
+
+func (res historyResponse) toHistoryBuckets() []HistoryBucket {
+	buckets := make([]HistoryBucket, len(res.History))
+
+	for i, b := range res.History {
+		buckets[i] = HistoryBucket{
+			Timestamp: unixTime(b.Timestamp),
+			Total:     b.Total,
+			Cached:    b.Cached,
+			Blocked:   b.Blocked,
+			Forwarded: b.Forwarded,
+		}
+	}
+
+	return buckets
+}
+
+func (res clientHistoryResponse) toClientHistory() *ClientHistory {
+	h := &ClientHistory{
+		Clients: make(map[string]HistoryClient, len(res.Clients)),
+		Buckets: make([]ClientHistoryBucket, len(res.History)),
+	}
+
+	for ip, c := range res.Clients {
+		h.Clients[ip] = HistoryClient{Name: stringValue(c.Name), Total: c.Total}
+	}
+
+	for i, b := range res.History {
+		h.Buckets[i] = ClientHistoryBucket{Timestamp: unixTime(b.Timestamp), Counts: b.Data}
+	}
+
+	return h
+}
+
+// path returns the in-memory or database endpoint for a history along with the applicable options
+func (opts HistoryOptions) path(endpoint string) string {
+	vals := url.Values{}
+
+	path := fmt.Sprintf("/api/history%s", endpoint)
+	if !opts.From.IsZero() || !opts.Until.IsZero() {
+		path = fmt.Sprintf("/api/history/database%s", endpoint)
+
+		var from int64
+		if !opts.From.IsZero() {
+			from = opts.From.Unix()
+		}
+		vals.Set("from", strconv.FormatInt(from, 10))
+
+		until := opts.Until
+		if until.IsZero() {
+			until = time.Now()
+		}
+		vals.Set("until", strconv.FormatInt(until.Unix(), 10))
+	} else if opts.Clients != 0 {
+		vals.Set("N", strconv.Itoa(opts.Clients))
+	}
+
+	if len(vals) == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?%s", path, vals.Encode())
+}
+
+// Get returns the query volume over time
+func (h history) Get(ctx context.Context, opts HistoryOptions) ([]HistoryBucket, error) {
+	var res historyResponse
+	if err := h.client.getJSON(ctx, opts.path(""), &res); err != nil {
+		return nil, err
+	}
+
+	return res.toHistoryBuckets(), nil
+}
+
+// Clients returns the query volume over time per client
+func (h history) Clients(ctx context.Context, opts HistoryOptions) (*ClientHistory, error) {
+	var res clientHistoryResponse
+	if err := h.client.getJSON(ctx, opts.path("/clients"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.toClientHistory(), nil
+}
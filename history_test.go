@@ -0,0 +1,95 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryOptionsPath(t *testing.T) {
+	from := time.Unix(1700000000, 0)
+	until := time.Unix(1700003600, 0)
+
+	tcs := []struct {
+		name     string
+		opts     HistoryOptions
+		endpoint string
+		expected string
+	}{
+		{
+			name:     "no options",
+			opts:     HistoryOptions{},
+			expected: "/api/history",
+		},
+		{
+			name:     "client limit",
+			opts:     HistoryOptions{Clients: 5},
+			endpoint: "/clients",
+			expected: "/api/history/clients?N=5",
+		},
+		{
+			name:     "time range reads from the database",
+			opts:     HistoryOptions{From: from, Until: until, Clients: 5},
+			endpoint: "/clients",
+			expected: "/api/history/database/clients?from=1700000000&until=1700003600",
+		},
+		{
+			name:     "until only reads from the start of the database",
+			opts:     HistoryOptions{Until: until},
+			expected: "/api/history/database?from=0&until=1700003600",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			isUnit(t)
+
+			assert.Equal(t, tc.expected, tc.opts.path(tc.endpoint))
+		})
+	}
+}
+
+func TestHistory(t *testing.T) {
+	t.Run("Test get history", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		buckets, err := c.History.Get(context.Background(), HistoryOptions{})
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, buckets)
+	})
+
+	t.Run("Test get client history", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		history, err := c.History.Clients(context.Background(), HistoryOptions{Clients: 5})
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, len(history.Clients), 6)
+	})
+
+	t.Run("Test get database history", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+		opts := HistoryOptions{From: time.Now().Add(-24 * time.Hour), Until: time.Now()}
+
+		_, err := c.History.Get(ctx, opts)
+		require.NoError(t, err)
+
+		_, err = c.History.Clients(ctx, opts)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,524 @@
+package pihole
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type Info interface {
+	// Version returns the installed and latest available versions of the Pi-hole components.
+	Version(ctx context.Context) (*Version, error)
+
+	// System returns resource usage of the Pi-hole host.
+	System(ctx context.Context) (*SystemInfo, error)
+
+	// Host returns details about the Pi-hole host.
+	Host(ctx context.Context) (*HostInfo, error)
+
+	// FTL returns details about the running FTL process.
+	FTL(ctx context.Context) (*FTLInfo, error)
+
+	// Sensors returns the temperature sensor readings of the Pi-hole host.
+	Sensors(ctx context.Context) (*SensorsInfo, error)
+
+	// Database returns details about the long-term query database.
+	Database(ctx context.Context) (*DatabaseInfo, error)
+
+	// Client returns the request details Pi-hole sees for this client.
+	Client(ctx context.Context) (*ClientInfo, error)
+}
+
+type info struct {
+	client *Client
+}
+
+type Version struct {
+	Core   ComponentVersion
+	Web    ComponentVersion
+	FTL    ComponentVersion
+	Docker DockerVersion
+}
+
+type ComponentVersion struct {
+	Branch        string
+	Version       string
+	Hash          string
+	Date          string
+	RemoteVersion string
+	RemoteHash    string
+}
+
+type DockerVersion struct {
+	Local  string
+	Remote string
+}
+
+// UpdateAvailable reports whether a newer version of the component has been released
+func (v ComponentVersion) UpdateAvailable() bool {
+	return v.RemoteVersion != "" && v.Version != v.RemoteVersion
+}
+
+type SystemInfo struct {
+	Uptime        time.Duration
+	RAM           MemoryUsage
+	Swap          MemoryUsage
+	Procs         int
+	CPUCores      int
+	CPUPercent    float64
+	Load          []float64
+	LoadPercent   []float64
+	FTLMemPercent float64
+	FTLCPUPercent float64
+}
+
+// MemoryUsage is memory usage in kibibytes
+type MemoryUsage struct {
+	Total       int64
+	Free        int64
+	Used        int64
+	Available   int64
+	UsedPercent float64
+}
+
+type HostInfo struct {
+	DomainName string
+	Machine    string
+	NodeName   string
+	Release    string
+	SysName    string
+	Version    string
+	Model      string
+}
+
+type FTLInfo struct {
+	PID              int
+	Uptime           time.Duration
+	PrivacyLevel     int
+	QueryFrequency   float64
+	MemPercent       float64
+	CPUPercent       float64
+	AllowDestructive bool
+	Clients          StatsClients
+	Database         FTLDatabaseInfo
+}
+
+type FTLDatabaseInfo struct {
+	Gravity        int
+	Groups         int
+	Lists          int
+	Clients        int
+	AllowedDomains FTLDomainCount
+	DeniedDomains  FTLDomainCount
+	AllowedRegex   FTLDomainCount
+	DeniedRegex    FTLDomainCount
+}
+
+type FTLDomainCount struct {
+	Total   int
+	Enabled int
+}
+
+type SensorsInfo struct {
+	Sensors []Sensor
+
+	// CPUTemp is nil when the CPU temperature could not be determined
+	CPUTemp  *float64
+	HotLimit float64
+	Unit     string
+}
+
+type Sensor struct {
+	Name   string
+	Path   string
+	Source string
+	Temps  []SensorTemp
+}
+
+type SensorTemp struct {
+	Name   string
+	Value  float64
+	Max    *float64
+	Crit   *float64
+	Sensor string
+}
+
+type DatabaseInfo struct {
+	Size              int64
+	Type              string
+	Modified          time.Time
+	Queries           int
+	EarliestTimestamp time.Time
+	SQLiteVersion     string
+}
+
+type ClientInfo struct {
+	RemoteAddr  string
+	HTTPVersion string
+	Method      string
+	Headers     http.Header
+}
+
+type componentVersionResponse struct {
+	Local struct {
+		Branch  *string `json:"branch"`
+		Version *string `json:"version"`
+		Hash    *string `json:"hash"`
+		Date    *string `json:"date"`
+	} `json:"local"`
+	Remote struct {
+		Version *string `json:"version"`
+		Hash    *string `json:"hash"`
+	} `json:"remote"`
+}
+
+type versionResponse struct {
+	Version struct {
+		Core   componentVersionResponse `json:"core"`
+		Web    componentVersionResponse `json:"web"`
+		FTL    componentVersionResponse `json:"ftl"`
+		Docker struct {
+			Local  *string `json:"local"`
+			Remote *string `json:"remote"`
+		} `json:"docker"`
+	} `json:"version"`
+}
+
+type memoryUsageResponse struct {
+	Total       int64   `json:"total"`
+	Free        int64   `json:"free"`
+	Used        int64   `json:"used"`
+	Available   int64   `json:"available"`
+	UsedPercent float64 `json:"%used"`
+}
+
+type systemInfoResponse struct {
+	System struct {
+		Uptime int64 `json:"uptime"`
+		Memory struct {
+			RAM  memoryUsageResponse `json:"ram"`
+			Swap memoryUsageResponse `json:"swap"`
+		} `json:"memory"`
+		Procs int `json:"procs"`
+		CPU   struct {
+			NProcs     int     `json:"nprocs"`
+			CPUPercent float64 `json:"%cpu"`
+			Load       struct {
+				Raw     []float64 `json:"raw"`
+				Percent []float64 `json:"percent"`
+			} `json:"load"`
+		} `json:"cpu"`
+		FTL struct {
+			MemPercent float64 `json:"%mem"`
+			CPUPercent float64 `json:"%cpu"`
+		} `json:"ftl"`
+	} `json:"system"`
+}
+
+type hostInfoResponse struct {
+	Host struct {
+		Uname struct {
+			DomainName string `json:"domainname"`
+			Machine    string `json:"machine"`
+			NodeName   string `json:"nodename"`
+			Release    string `json:"release"`
+			SysName    string `json:"sysname"`
+			Version    string `json:"version"`
+		} `json:"uname"`
+		Model *string `json:"model"`
+	} `json:"host"`
+}
+
+type ftlDomainCountResponse struct {
+	Total   int `json:"total"`
+	Enabled int `json:"enabled"`
+}
+
+type ftlInfoResponse struct {
+	FTL struct {
+		Database struct {
+			Gravity int `json:"gravity"`
+			Groups  int `json:"groups"`
+			Lists   int `json:"lists"`
+			Clients int `json:"clients"`
+			Domains struct {
+				Allowed ftlDomainCountResponse `json:"allowed"`
+				Denied  ftlDomainCountResponse `json:"denied"`
+			} `json:"domains"`
+			Regex struct {
+				Allowed ftlDomainCountResponse `json:"allowed"`
+				Denied  ftlDomainCountResponse `json:"denied"`
+			} `json:"regex"`
+		} `json:"database"`
+		PrivacyLevel   int     `json:"privacy_level"`
+		QueryFrequency float64 `json:"query_frequency"`
+		Clients        struct {
+			Total  int `json:"total"`
+			Active int `json:"active"`
+		} `json:"clients"`
+		PID              int     `json:"pid"`
+		Uptime           int64   `json:"uptime"`
+		MemPercent       float64 `json:"%mem"`
+		CPUPercent       float64 `json:"%cpu"`
+		AllowDestructive bool    `json:"allow_destructive"`
+	} `json:"ftl"`
+}
+
+type sensorsInfoResponse struct {
+	Sensors struct {
+		List []struct {
+			Name   string  `json:"name"`
+			Path   string  `json:"path"`
+			Source *string `json:"source"`
+			Temps  []struct {
+				Name   *string  `json:"name"`
+				Value  float64  `json:"value"`
+				Max    *float64 `json:"max"`
+				Crit   *float64 `json:"crit"`
+				Sensor string   `json:"sensor"`
+			} `json:"temps"`
+		} `json:"list"`
+		CPUTemp  *float64 `json:"cpu_temp"`
+		HotLimit float64  `json:"hot_limit"`
+		Unit     string   `json:"unit"`
+	} `json:"sensors"`
+}
+
+type databaseInfoResponse struct {
+	Size              int64  `json:"size"`
+	Type              string `json:"type"`
+	MTime             int64  `json:"mtime"`
+	Queries           int    `json:"queries"`
+	EarliestTimestamp int64  `json:"earliest_timestamp"`
+	SQLiteVersion     string `json:"sqlite_version"`
+}
+
+type clientInfoResponse struct {
+	RemoteAddr  string `json:"remote_addr"`
+	HTTPVersion string `json:"http_version"`
+	Method      string `json:"method"`
+	Headers     []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"headers"`
+}
+
+func (res componentVersionResponse) toComponentVersion() ComponentVersion {
+	return ComponentVersion{
+		Branch:        stringValue(res.Local.Branch),
+		Version:       stringValue(res.Local.Version),
+		Hash:          stringValue(res.Local.Hash),
+		Date:          stringValue(res.Local.Date),
+		RemoteVersion: stringValue(res.Remote.Version),
+		RemoteHash:    stringValue(res.Remote.Hash),
+	}
+}
+
+func (res versionResponse) toVersion() *Version {
+	return &Version{
+		Core: res.Version.Core.toComponentVersion(),
+		Web:  res.Version.Web.toComponentVersion(),
+		FTL:  res.Version.FTL.toComponentVersion(),
+		Docker: DockerVersion{
+			Local:  stringValue(res.Version.Docker.Local),
+			Remote: stringValue(res.Version.Docker.Remote),
+		},
+	}
+}
+
+func (res memoryUsageResponse) toMemoryUsage() MemoryUsage {
+	return MemoryUsage{
+		Total:       res.Total,
+		Free:        res.Free,
+		Used:        res.Used,
+		Available:   res.Available,
+		UsedPercent: res.UsedPercent,
+	}
+}
+
+func (res systemInfoResponse) toSystemInfo() *SystemInfo {
+	return &SystemInfo{
+		Uptime:        time.Duration(res.System.Uptime) * time.Second,
+		RAM:           res.System.Memory.RAM.toMemoryUsage(),
+		Swap:          res.System.Memory.Swap.toMemoryUsage(),
+		Procs:         res.System.Procs,
+		CPUCores:      res.System.CPU.NProcs,
+		CPUPercent:    res.System.CPU.CPUPercent,
+		Load:          res.System.CPU.Load.Raw,
+		LoadPercent:   res.System.CPU.Load.Percent,
+		FTLMemPercent: res.System.FTL.MemPercent,
+		FTLCPUPercent: res.System.FTL.CPUPercent,
+	}
+}
+
+func (res hostInfoResponse) toHostInfo() *HostInfo {
+	return &HostInfo{
+		DomainName: res.Host.Uname.DomainName,
+		Machine:    res.Host.Uname.Machine,
+		NodeName:   res.Host.Uname.NodeName,
+		Release:    res.Host.Uname.Release,
+		SysName:    res.Host.Uname.SysName,
+		Version:    res.Host.Uname.Version,
+		Model:      stringValue(res.Host.Model),
+	}
+}
+
+func (res ftlDomainCountResponse) toFTLDomainCount() FTLDomainCount {
+	return FTLDomainCount{Total: res.Total, Enabled: res.Enabled}
+}
+
+func (res ftlInfoResponse) toFTLInfo() *FTLInfo {
+	db := res.FTL.Database
+
+	return &FTLInfo{
+		PID:              res.FTL.PID,
+		Uptime:           time.Duration(res.FTL.Uptime) * time.Millisecond,
+		PrivacyLevel:     res.FTL.PrivacyLevel,
+		QueryFrequency:   res.FTL.QueryFrequency,
+		MemPercent:       res.FTL.MemPercent,
+		CPUPercent:       res.FTL.CPUPercent,
+		AllowDestructive: res.FTL.AllowDestructive,
+		Clients: StatsClients{
+			Total:  res.FTL.Clients.Total,
+			Active: res.FTL.Clients.Active,
+		},
+		Database: FTLDatabaseInfo{
+			Gravity:        db.Gravity,
+			Groups:         db.Groups,
+			Lists:          db.Lists,
+			Clients:        db.Clients,
+			AllowedDomains: db.Domains.Allowed.toFTLDomainCount(),
+			DeniedDomains:  db.Domains.Denied.toFTLDomainCount(),
+			AllowedRegex:   db.Regex.Allowed.toFTLDomainCount(),
+			DeniedRegex:    db.Regex.Denied.toFTLDomainCount(),
+		},
+	}
+}
+
+func (res sensorsInfoResponse) toSensorsInfo() *SensorsInfo {
+	sensors := &SensorsInfo{
+		Sensors:  make([]Sensor, len(res.Sensors.List)),
+		CPUTemp:  res.Sensors.CPUTemp,
+		HotLimit: res.Sensors.HotLimit,
+		Unit:     res.Sensors.Unit,
+	}
+
+	for i, s := range res.Sensors.List {
+		sensor := Sensor{
+			Name:   s.Name,
+			Path:   s.Path,
+			Source: stringValue(s.Source),
+			Temps:  make([]SensorTemp, len(s.Temps)),
+		}
+
+		for j, temp := range s.Temps {
+			sensor.Temps[j] = SensorTemp{
+				Name:   stringValue(temp.Name),
+				Value:  temp.Value,
+				Max:    temp.Max,
+				Crit:   temp.Crit,
+				Sensor: temp.Sensor,
+			}
+		}
+
+		sensors.Sensors[i] = sensor
+	}
+
+	return sensors
+}
+
+func (res databaseInfoResponse) toDatabaseInfo() *DatabaseInfo {
+	return &DatabaseInfo{
+		Size:              res.Size,
+		Type:              res.Type,
+		Modified:          time.Unix(res.MTime, 0),
+		Queries:           res.Queries,
+		EarliestTimestamp: time.Unix(res.EarliestTimestamp, 0),
+		SQLiteVersion:     res.SQLiteVersion,
+	}
+}
+
+func (res clientInfoResponse) toClientInfo() *ClientInfo {
+	info := &ClientInfo{
+		RemoteAddr:  res.RemoteAddr,
+		HTTPVersion: res.HTTPVersion,
+		Method:      res.Method,
+		Headers:     make(http.Header, len(res.Headers)),
+	}
+
+	for _, header := range res.Headers {
+		info.Headers.Add(header.Name, header.Value)
+	}
+
+	return info
+}
+
+// Version returns the installed and latest available versions of the Pi-hole components
+func (i info) Version(ctx context.Context) (*Version, error) {
+	var res versionResponse
+	if err := i.client.getJSON(ctx, "/api/info/version", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toVersion(), nil
+}
+
+// System returns resource usage of the Pi-hole host
+func (i info) System(ctx context.Context) (*SystemInfo, error) {
+	var res systemInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/system", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toSystemInfo(), nil
+}
+
+// Host returns details about the Pi-hole host
+func (i info) Host(ctx context.Context) (*HostInfo, error) {
+	var res hostInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/host", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toHostInfo(), nil
+}
+
+// FTL returns details about the running FTL process
+func (i info) FTL(ctx context.Context) (*FTLInfo, error) {
+	var res ftlInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/ftl", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toFTLInfo(), nil
+}
+
+// Sensors returns the temperature sensor readings of the Pi-hole host
+func (i info) Sensors(ctx context.Context) (*SensorsInfo, error) {
+	var res sensorsInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/sensors", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toSensorsInfo(), nil
+}
+
+// Database returns details about the long-term query database
+func (i info) Database(ctx context.Context) (*DatabaseInfo, error) {
+	var res databaseInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/database", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toDatabaseInfo(), nil
+}
+
+// Client returns the request details Pi-hole sees for this client
+func (i info) Client(ctx context.Context) (*ClientInfo, error) {
+	var res clientInfoResponse
+	if err := i.client.getJSON(ctx, "/api/info/client", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toClientInfo(), nil
+}
@@ -0,0 +1,78 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentVersionUpdateAvailable(t *testing.T) {
+	tcs := []struct {
+		name     string
+		version  ComponentVersion
+		expected bool
+	}{
+		{
+			name:     "up to date",
+			version:  ComponentVersion{Version: "v6.0.5", RemoteVersion: "v6.0.5"},
+			expected: false,
+		},
+		{
+			name:     "outdated",
+			version:  ComponentVersion{Version: "v6.0.4", RemoteVersion: "v6.0.5"},
+			expected: true,
+		},
+		{
+			name:     "remote unknown",
+			version:  ComponentVersion{Version: "v6.0.4"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			isUnit(t)
+
+			assert.Equal(t, tc.expected, tc.version.UpdateAvailable())
+		})
+	}
+}
+
+func TestInfo(t *testing.T) {
+	t.Run("Test get info", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		version, err := c.Info.Version(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, version.FTL.Version)
+
+		system, err := c.Info.System(ctx)
+		require.NoError(t, err)
+		assert.NotZero(t, system.RAM.Total)
+
+		host, err := c.Info.Host(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Linux", host.SysName)
+
+		ftl, err := c.Info.FTL(ctx)
+		require.NoError(t, err)
+		assert.NotZero(t, ftl.PID)
+
+		_, err = c.Info.Sensors(ctx)
+		require.NoError(t, err)
+
+		_, err = c.Info.Database(ctx)
+		require.NoError(t, err)
+
+		client, err := c.Info.Client(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "go-pihole", client.Headers.Get("User-Agent"))
+	})
+}
@@ -0,0 +1,290 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type Lists interface {
+	// List all subscription lists.
+	List(ctx context.Context) (SubscriptionList, error)
+
+	// Get a subscription list by its type and address.
+	Get(ctx context.Context, listType SubscriptionType, address string) (*Subscription, error)
+
+	// Create a subscription list.
+	Create(ctx context.Context, req SubscriptionRequest) (*Subscription, error)
+
+	// Update a subscription list identified by the request type and address.
+	Update(ctx context.Context, req SubscriptionRequest) (*Subscription, error)
+
+	// Delete a subscription list by its type and address.
+	Delete(ctx context.Context, listType SubscriptionType, address string) error
+}
+
+var (
+	ErrorListNotFound = errors.New("list not found")
+)
+
+// SubscriptionType is whether the domains of a list are allowed or blocked
+type SubscriptionType string
+
+const (
+	SubscriptionTypeAllow SubscriptionType = "allow"
+	SubscriptionTypeBlock SubscriptionType = "block"
+)
+
+// SubscriptionStatus is the outcome of the last gravity download of a list
+type SubscriptionStatus int
+
+const (
+	SubscriptionStatusUnknown SubscriptionStatus = iota
+	SubscriptionStatusUpdated
+	SubscriptionStatusUnchanged
+	SubscriptionStatusUnavailableCached
+	SubscriptionStatusUnavailable
+)
+
+type lists struct {
+	client *Client
+}
+
+type Subscription struct {
+	ID             int
+	Address        string
+	Type           SubscriptionType
+	Comment        string
+	Groups         []int
+	Enabled        bool
+	DateAdded      time.Time
+	DateModified   time.Time
+	DateUpdated    time.Time
+	Number         int
+	InvalidDomains int
+	ABPEntries     int
+	Status         SubscriptionStatus
+}
+
+type SubscriptionList []Subscription
+
+// SubscriptionRequest describes a list to create or update. Enabled must be set for the list to take effect.
+// Updating with nil Groups leaves the list's groups as they are, an empty slice removes them all.
+type SubscriptionRequest struct {
+	Address string
+	Type    SubscriptionType
+	Comment string
+	Groups  []int
+	Enabled bool
+}
+
+type subscriptionListResponse struct {
+	Lists     []subscriptionResponse `json:"lists"`
+	Processed *processedResponse     `json:"processed"`
+}
+
+type subscriptionResponse struct {
+	ID             int                `json:"id"`
+	Address        string             `json:"address"`
+	Type           SubscriptionType   `json:"type"`
+	Comment        *string            `json:"comment"`
+	Groups         []int              `json:"groups"`
+	Enabled        bool               `json:"enabled"`
+	DateAdded      int64              `json:"date_added"`
+	DateModified   int64              `json:"date_modified"`
+	DateUpdated    int64              `json:"date_updated"`
+	Number         int                `json:"number"`
+	InvalidDomains int                `json:"invalid_domains"`
+	ABPEntries     int                `json:"abp_entries"`
+	Status         SubscriptionStatus `json:"status"`
+}
+
+type subscriptionCreateRequest struct {
+	Address string `json:"address"`
+	Comment string `json:"comment"`
+	Groups  []int  `json:"groups,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+type subscriptionUpdateRequest struct {
+	Type    SubscriptionType `json:"type"`
+	Comment string           `json:"comment"`
+	Groups  *[]int           `json:"groups,omitempty"`
+	Enabled bool             `json:"enabled"`
+}
+
+func (res subscriptionResponse) toSubscription() Subscription {
+	s := Subscription{
+		ID:             res.ID,
+		Address:        res.Address,
+		Type:           res.Type,
+		Groups:         res.Groups,
+		Enabled:        res.Enabled,
+		DateAdded:      time.Unix(res.DateAdded, 0),
+		DateModified:   time.Unix(res.DateModified, 0),
+		DateUpdated:    time.Unix(res.DateUpdated, 0),
+		Number:         res.Number,
+		InvalidDomains: res.InvalidDomains,
+		ABPEntries:     res.ABPEntries,
+		Status:         res.Status,
+	}
+
+	if res.Comment != nil {
+		s.Comment = *res.Comment
+	}
+
+	return s
+}
+
+func (res subscriptionListResponse) toSubscriptionList() SubscriptionList {
+	list := make(SubscriptionList, len(res.Lists))
+
+	for i, s := range res.Lists {
+		list[i] = s.toSubscription()
+	}
+
+	return list
+}
+
+func listPath(listType SubscriptionType, address string) string {
+	path := "/api/lists"
+	if address != "" {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(address))
+	}
+
+	return fmt.Sprintf("%s?%s", path, url.Values{"type": []string{string(listType)}}.Encode())
+}
+
+// List returns all allow and block subscription lists
+func (l lists) List(ctx context.Context) (SubscriptionList, error) {
+	res, err := l.client.Get(ctx, "/api/lists")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList subscriptionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse list body: %w", err)
+	}
+
+	return resList.toSubscriptionList(), nil
+}
+
+// Get returns a subscription list by its type and address
+func (l lists) Get(ctx context.Context, listType SubscriptionType, address string) (*Subscription, error) {
+	res, err := l.client.Get(ctx, listPath(listType, address))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorListNotFound, address)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList subscriptionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse list body: %w", err)
+	}
+
+	if len(resList.Lists) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorListNotFound, address)
+	}
+
+	subscription := resList.Lists[0].toSubscription()
+
+	return &subscription, nil
+}
+
+// Create adds an allow or block subscription list
+func (l lists) Create(ctx context.Context, req SubscriptionRequest) (*Subscription, error) {
+	res, err := l.client.Post(ctx, listPath(req.Type, ""), subscriptionCreateRequest{
+		Address: req.Address,
+		Comment: req.Comment,
+		Groups:  req.Groups,
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList subscriptionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse list response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to create list %s: %w", req.Address, err)
+	}
+
+	return l.Get(ctx, req.Type, req.Address)
+}
+
+// Update replaces the comment, groups and enabled state of a subscription list
+func (l lists) Update(ctx context.Context, req SubscriptionRequest) (*Subscription, error) {
+	res, err := l.client.Put(ctx, listPath(req.Type, req.Address), subscriptionUpdateRequest{
+		Type:    req.Type,
+		Comment: req.Comment,
+		Groups:  groupsUpdate(req.Groups),
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrorListNotFound, req.Address)
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList subscriptionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse list response body: %w", err)
+	}
+
+	if err := resList.Processed.err(); err != nil {
+		return nil, fmt.Errorf("failed to update list %s: %w", req.Address, err)
+	}
+
+	return l.Get(ctx, req.Type, req.Address)
+}
+
+// Delete removes a subscription list
+func (l lists) Delete(ctx context.Context, listType SubscriptionType, address string) error {
+	res, err := l.client.Delete(ctx, listPath(listType, address))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrorListNotFound, address)
+	default:
+		return unexpectedStatus(res)
+	}
+}
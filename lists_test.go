@@ -0,0 +1,114 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cleanupList(t *testing.T, c *Client, listType SubscriptionType, address string) {
+	if err := c.Lists.Delete(context.TODO(), listType, address); err != nil {
+		log.Printf("Failed to clean up list: %s\n", address)
+	}
+}
+
+func TestLists(t *testing.T) {
+	tcs := []struct {
+		name     string
+		listType SubscriptionType
+	}{
+		{
+			name:     "allow",
+			listType: SubscriptionTypeAllow,
+		},
+		{
+			name:     "block",
+			listType: SubscriptionTypeBlock,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(fmt.Sprintf("Test create, update and delete %s list", tc.name), func(t *testing.T) {
+			isAcceptance(t)
+
+			c := newTestClient(t)
+			defer cleanupTestClient(c)
+
+			ctx := context.Background()
+
+			address := fmt.Sprintf("https://%s.example.com/list.txt", randomID())
+
+			list, err := c.Lists.Create(ctx, SubscriptionRequest{
+				Address: address,
+				Type:    tc.listType,
+				Comment: "created",
+				Enabled: true,
+			})
+			require.NoError(t, err)
+			defer cleanupList(t, c, tc.listType, address)
+
+			assert.Equal(t, address, list.Address)
+			assert.Equal(t, tc.listType, list.Type)
+			assert.Equal(t, "created", list.Comment)
+			assert.True(t, list.Enabled)
+
+			list, err = c.Lists.Update(ctx, SubscriptionRequest{
+				Address: address,
+				Type:    tc.listType,
+				Comment: "updated",
+				Enabled: false,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, "updated", list.Comment)
+			assert.False(t, list.Enabled)
+
+			all, err := c.Lists.List(ctx)
+			require.NoError(t, err)
+			assert.Contains(t, all, *list)
+
+			err = c.Lists.Delete(ctx, tc.listType, address)
+			require.NoError(t, err)
+
+			_, err = c.Lists.Get(ctx, tc.listType, address)
+			assert.ErrorIs(t, err, ErrorListNotFound)
+		})
+	}
+}
+
+func TestListsUpdateGroups(t *testing.T) {
+	t.Run("Test update with empty groups removes every group", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				b, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"type":"block","comment":"","groups":[],"enabled":true}`, string(b))
+			}
+
+			fmt.Fprint(w, `{"lists":[{"address":"https://lists.example.com/ads.txt","type":"block","groups":[],"enabled":true,"id":1}]}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		list, err := c.Lists.Update(context.Background(), SubscriptionRequest{
+			Address: "https://lists.example.com/ads.txt",
+			Type:    SubscriptionTypeBlock,
+			Groups:  []int{},
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, list.Groups)
+	})
+}
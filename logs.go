@@ -0,0 +1,181 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Logs interface {
+	// Get returns the buffered lines of a log starting at nextID.
+	Get(ctx context.Context, source LogSource, nextID int) (*LogPage, error)
+
+	// Follow polls a log every interval, emitting lines written after the call until the context is cancelled
+	// or polling keeps failing.
+	Follow(ctx context.Context, source LogSource, interval time.Duration) (<-chan LogLine, error)
+}
+
+// LogSource is a Pi-hole log that can be read through the API
+type LogSource string
+
+const (
+	LogSourceDnsmasq   LogSource = "dnsmasq"
+	LogSourceFTL       LogSource = "ftl"
+	LogSourceWebserver LogSource = "webserver"
+)
+
+var (
+	ErrorLogFollowFailed = errors.New("failed to follow log")
+)
+
+// maxFollowFailures is how many polls in a row may fail before Follow gives up
+const maxFollowFailures = 3
+
+type logs struct {
+	client *Client
+}
+
+type LogLine struct {
+	Timestamp time.Time
+	Message   string
+	Prio      string
+
+	// Err is only set on the final line sent by Follow when polling failed repeatedly
+	Err error
+}
+
+// LogPage is a set of log lines along with the ID to request the following lines with
+type LogPage struct {
+	Lines  []LogLine
+	NextID int
+	PID    int
+	File   string
+}
+
+type logPageResponse struct {
+	Log    []logLineResponse `json:"log"`
+	NextID int               `json:"nextID"`
+	PID    int               `json:"pid"`
+	File   string            `json:"file"`
+}
+
+type logLineResponse struct {
+	Timestamp float64 `json:"timestamp"`
+	Message   string  `json:"message"`
+	Prio      *string `json:"prio"`
+}
+
+func (res logPageResponse) toLogPage() *LogPage {
+	page := &LogPage{
+		Lines:  make([]LogLine, len(res.Log)),
+		NextID: res.NextID,
+		PID:    res.PID,
+		File:   res.File,
+	}
+
+	for i, line := range res.Log {
+		page.Lines[i] = LogLine{
+			Timestamp: unixTime(line.Timestamp),
+			Message:   line.Message,
+			Prio:      stringValue(line.Prio),
+		}
+	}
+
+	return page
+}
+
+// Get returns the buffered lines of a log starting at nextID
+func (l logs) Get(ctx context.Context, source LogSource, nextID int) (*LogPage, error) {
+	res, err := l.client.Get(ctx, fmt.Sprintf("/api/logs/%s?nextID=%d", source, nextID))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var pageRes logPageResponse
+	if err := json.NewDecoder(res.Body).Decode(&pageRes); err != nil {
+		return nil, fmt.Errorf("failed to parse log body: %w", err)
+	}
+
+	return pageRes.toLogPage(), nil
+}
+
+// Follow emits lines written to a log after the call. Failed polls are retried on the next interval and
+// the log is read from the start again when FTL restarts. The channel is closed once the context is done,
+// or after a line carrying ErrorLogFollowFailed when several polls in a row failed.
+func (l logs) Follow(ctx context.Context, source LogSource, interval time.Duration) (<-chan LogLine, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("follow interval must be positive, got %s", interval)
+	}
+
+	page, err := l.Get(ctx, source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(chan LogLine)
+
+	go func() {
+		defer close(lines)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		nextID := page.NextID
+		pid := page.PID
+		failures := 0
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			page, err := l.Get(ctx, source, nextID)
+			if err == nil && page.PID != pid {
+				pid = page.PID
+				nextID = 0
+
+				page, err = l.Get(ctx, source, 0)
+			}
+
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if failures++; failures < maxFollowFailures {
+					continue
+				}
+
+				select {
+				case lines <- LogLine{Err: fmt.Errorf("%w: %s: %w", ErrorLogFollowFailed, source, err)}:
+				case <-ctx.Done():
+				}
+
+				return
+			}
+
+			failures = 0
+			nextID = page.NextID
+
+			for _, line := range page.Lines {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return lines, nil
+}
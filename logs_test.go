@@ -0,0 +1,130 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsFollow(t *testing.T) {
+	t.Run("Test follow emits new lines", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var mu sync.Mutex
+		written := []string{"old line"}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/logs/ftl", r.URL.Path)
+
+			nextID, _ := strconv.Atoi(r.URL.Query().Get("nextID"))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			var res logPageResponse
+			for _, message := range written[nextID:] {
+				res.Log = append(res.Log, logLineResponse{Message: message})
+			}
+			res.NextID = len(written)
+			res.PID = 1
+
+			_ = json.NewEncoder(w).Encode(res)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		lines, err := c.Logs.Follow(ctx, LogSourceFTL, 10*time.Millisecond)
+		require.NoError(t, err)
+
+		mu.Lock()
+		for i := 0; i < 3; i++ {
+			written = append(written, fmt.Sprintf("new line %d", i))
+		}
+		mu.Unlock()
+
+		var messages []string
+		for len(messages) < 3 {
+			messages = append(messages, (<-lines).Message)
+		}
+
+		assert.Equal(t, []string{"new line 0", "new line 1", "new line 2"}, messages)
+
+		cancel()
+		for range lines {
+		}
+	})
+
+	t.Run("Test follow rejects a non positive interval", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		c, err := New(Config{BaseURL: "http://localhost:8080", SessionID: "test"})
+		require.NoError(t, err)
+
+		_, err = c.Logs.Follow(context.Background(), LogSourceFTL, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Test follow stops when polling keeps failing", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var polls int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&polls, 1) > 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			fmt.Fprint(w, `{"log":[],"nextID":0,"pid":1}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		lines, err := c.Logs.Follow(context.Background(), LogSourceFTL, time.Millisecond)
+		require.NoError(t, err)
+
+		line, ok := <-lines
+		require.True(t, ok)
+		assert.ErrorIs(t, line.Err, ErrorLogFollowFailed)
+
+		_, ok = <-lines
+		assert.False(t, ok)
+	})
+}
+
+func TestLogs(t *testing.T) {
+	t.Run("Test get logs", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		for _, source := range []LogSource{LogSourceDnsmasq, LogSourceFTL, LogSourceWebserver} {
+			page, err := c.Logs.Get(ctx, source, 0)
+			require.NoError(t, err)
+
+			assert.NotEmpty(t, page.File)
+		}
+	})
+}
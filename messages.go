@@ -0,0 +1,128 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Messages interface {
+	// List all diagnostic messages.
+	List(ctx context.Context) (MessageList, error)
+
+	// Count returns the number of diagnostic messages.
+	Count(ctx context.Context) (int, error)
+
+	// Delete a diagnostic message by its ID.
+	Delete(ctx context.Context, id int) error
+}
+
+var (
+	ErrorMessageNotFound = errors.New("message not found")
+)
+
+type messages struct {
+	client *Client
+}
+
+// Message is a diagnostic message raised by Pi-hole, such as a rate limited client or a dnsmasq warning
+type Message struct {
+	ID        int
+	Timestamp time.Time
+	Type      string
+	Plain     string
+	HTML      string
+}
+
+type MessageList []Message
+
+type messageListResponse struct {
+	Messages []struct {
+		ID        int     `json:"id"`
+		Timestamp float64 `json:"timestamp"`
+		Type      string  `json:"type"`
+		Plain     string  `json:"plain"`
+		HTML      string  `json:"html"`
+	} `json:"messages"`
+}
+
+type messageCountResponse struct {
+	Count int `json:"count"`
+}
+
+func (res messageListResponse) toMessageList() MessageList {
+	list := make(MessageList, len(res.Messages))
+
+	for i, m := range res.Messages {
+		list[i] = Message{
+			ID:        m.ID,
+			Timestamp: unixTime(m.Timestamp),
+			Type:      m.Type,
+			Plain:     m.Plain,
+			HTML:      m.HTML,
+		}
+	}
+
+	return list
+}
+
+// List returns all diagnostic messages
+func (m messages) List(ctx context.Context) (MessageList, error) {
+	res, err := m.client.Get(ctx, "/api/info/messages")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList messageListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse message list body: %w", err)
+	}
+
+	return resList.toMessageList(), nil
+}
+
+// Count returns the number of diagnostic messages
+func (m messages) Count(ctx context.Context) (int, error) {
+	res, err := m.client.Get(ctx, "/api/info/messages/count")
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, unexpectedStatus(res)
+	}
+
+	var countRes messageCountResponse
+	if err := json.NewDecoder(res.Body).Decode(&countRes); err != nil {
+		return 0, fmt.Errorf("failed to parse message count body: %w", err)
+	}
+
+	return countRes.Count, nil
+}
+
+// Delete removes a diagnostic message by its ID
+func (m messages) Delete(ctx context.Context, id int) error {
+	res, err := m.client.Delete(ctx, fmt.Sprintf("/api/info/messages/%d", id))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %d", ErrorMessageNotFound, id)
+	default:
+		return unexpectedStatus(res)
+	}
+}
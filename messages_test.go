@@ -0,0 +1,28 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessages(t *testing.T) {
+	t.Run("Test list and count messages", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		list, err := c.Messages.List(ctx)
+		require.NoError(t, err)
+
+		count, err := c.Messages.Count(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, len(list), count)
+	})
+}
@@ -0,0 +1,351 @@
+package pihole
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Network interface {
+	// Devices returns the devices found in Pi-hole's network table.
+	Devices(ctx context.Context, opts NetworkDevicesOptions) (NetworkDeviceList, error)
+
+	// DeleteDevice removes a device from the network table by its ID.
+	DeleteDevice(ctx context.Context, id int) error
+
+	// Gateway returns the default gateways of the Pi-hole host.
+	Gateway(ctx context.Context) ([]Gateway, error)
+
+	// Interfaces returns the network interfaces of the Pi-hole host.
+	Interfaces(ctx context.Context) ([]NetworkInterface, error)
+
+	// Routes returns the routing table of the Pi-hole host.
+	Routes(ctx context.Context) ([]Route, error)
+}
+
+var (
+	ErrorNetworkDeviceNotFound = errors.New("network device not found")
+)
+
+type network struct {
+	client *Client
+}
+
+// NetworkDevicesOptions limits the number of devices and the number of addresses per device returned
+type NetworkDevicesOptions struct {
+	MaxDevices   int
+	MaxAddresses int
+}
+
+type NetworkDevice struct {
+	ID         int
+	HWAddr     string
+	Interface  string
+	FirstSeen  time.Time
+	LastQuery  time.Time
+	NumQueries int
+	MACVendor  string
+	IPs        []NetworkDeviceIP
+}
+
+type NetworkDeviceIP struct {
+	IP          string
+	Name        string
+	LastSeen    time.Time
+	NameUpdated time.Time
+}
+
+type NetworkDeviceList []NetworkDevice
+
+type Gateway struct {
+	Family    string
+	Interface string
+	Address   string
+	Local     []string
+}
+
+type NetworkInterface struct {
+	Name      string
+	Index     int
+	Type      string
+	Speed     int
+	State     string
+	Carrier   bool
+	Flags     []string
+	Address   string
+	Broadcast string
+	Addresses []NetworkInterfaceAddress
+}
+
+type NetworkInterfaceAddress struct {
+	Address   string
+	Family    string
+	PrefixLen int
+	Scope     string
+	Flags     []string
+	Local     string
+	Broadcast string
+	Label     string
+}
+
+type Route struct {
+	Table    int
+	Family   string
+	Protocol string
+	Scope    string
+	Type     string
+	Flags    []string
+	Dst      string
+	Gateway  string
+	Oif      string
+	PrefSrc  string
+	Priority int
+}
+
+type networkDeviceListResponse struct {
+	Devices []struct {
+		ID         int     `json:"id"`
+		HWAddr     string  `json:"hwaddr"`
+		Interface  string  `json:"interface"`
+		FirstSeen  int64   `json:"firstSeen"`
+		LastQuery  int64   `json:"lastQuery"`
+		NumQueries int     `json:"numQueries"`
+		MACVendor  *string `json:"macVendor"`
+		IPs        []struct {
+			IP          string  `json:"ip"`
+			Name        *string `json:"name"`
+			LastSeen    int64   `json:"lastSeen"`
+			NameUpdated int64   `json:"nameUpdated"`
+		} `json:"ips"`
+	} `json:"devices"`
+}
+
+type gatewayResponse struct {
+	Gateway []struct {
+		Family    string   `json:"family"`
+		Interface string   `json:"interface"`
+		Address   string   `json:"address"`
+		Local     []string `json:"local"`
+	} `json:"gateway"`
+}
+
+type networkInterfacesResponse struct {
+	Interfaces []struct {
+		Name      string   `json:"name"`
+		Index     int      `json:"index"`
+		Type      *string  `json:"type"`
+		Speed     *int     `json:"speed"`
+		State     *string  `json:"state"`
+		Carrier   bool     `json:"carrier"`
+		Flags     []string `json:"flags"`
+		Address   *string  `json:"address"`
+		Broadcast *string  `json:"broadcast"`
+		Addresses []struct {
+			Address   string   `json:"address"`
+			Family    string   `json:"family"`
+			PrefixLen int      `json:"prefixlen"`
+			Scope     *string  `json:"scope"`
+			Flags     []string `json:"flags"`
+			Local     *string  `json:"local"`
+			Broadcast *string  `json:"broadcast"`
+			Label     *string  `json:"label"`
+		} `json:"addresses"`
+	} `json:"interfaces"`
+}
+
+type routesResponse struct {
+	Routes []struct {
+		Table    int      `json:"table"`
+		Family   string   `json:"family"`
+		Protocol *string  `json:"protocol"`
+		Scope    *string  `json:"scope"`
+		Type     *string  `json:"type"`
+		Flags    []string `json:"flags"`
+		Dst      *string  `json:"dst"`
+		Gateway  *string  `json:"gateway"`
+		Oif      *string  `json:"oif"`
+		PrefSrc  *string  `json:"prefsrc"`
+		Priority int      `json:"priority"`
+	} `json:"routes"`
+}
+
+func (res networkDeviceListResponse) toNetworkDeviceList() NetworkDeviceList {
+	list := make(NetworkDeviceList, len(res.Devices))
+
+	for i, d := range res.Devices {
+		device := NetworkDevice{
+			ID:         d.ID,
+			HWAddr:     d.HWAddr,
+			Interface:  d.Interface,
+			FirstSeen:  time.Unix(d.FirstSeen, 0),
+			LastQuery:  time.Unix(d.LastQuery, 0),
+			NumQueries: d.NumQueries,
+			MACVendor:  stringValue(d.MACVendor),
+			IPs:        make([]NetworkDeviceIP, len(d.IPs)),
+		}
+
+		for j, ip := range d.IPs {
+			device.IPs[j] = NetworkDeviceIP{
+				IP:          ip.IP,
+				Name:        stringValue(ip.Name),
+				LastSeen:    time.Unix(ip.LastSeen, 0),
+				NameUpdated: time.Unix(ip.NameUpdated, 0),
+			}
+		}
+
+		list[i] = device
+	}
+
+	return list
+}
+
+func (res gatewayResponse) toGateways() []Gateway {
+	gateways := make([]Gateway, len(res.Gateway))
+
+	for i, g := range res.Gateway {
+		gateways[i] = Gateway{
+			Family:    g.Family,
+			Interface: g.Interface,
+			Address:   g.Address,
+			Local:     g.Local,
+		}
+	}
+
+	return gateways
+}
+
+func (res networkInterfacesResponse) toNetworkInterfaces() []NetworkInterface {
+	interfaces := make([]NetworkInterface, len(res.Interfaces))
+
+	for i, iface := range res.Interfaces {
+		ni := NetworkInterface{
+			Name:      iface.Name,
+			Index:     iface.Index,
+			Type:      stringValue(iface.Type),
+			State:     stringValue(iface.State),
+			Carrier:   iface.Carrier,
+			Flags:     iface.Flags,
+			Address:   stringValue(iface.Address),
+			Broadcast: stringValue(iface.Broadcast),
+			Addresses: make([]NetworkInterfaceAddress, len(iface.Addresses)),
+		}
+
+		if iface.Speed != nil {
+			ni.Speed = *iface.Speed
+		}
+
+		for j, addr := range iface.Addresses {
+			ni.Addresses[j] = NetworkInterfaceAddress{
+				Address:   addr.Address,
+				Family:    addr.Family,
+				PrefixLen: addr.PrefixLen,
+				Scope:     stringValue(addr.Scope),
+				Flags:     addr.Flags,
+				Local:     stringValue(addr.Local),
+				Broadcast: stringValue(addr.Broadcast),
+				Label:     stringValue(addr.Label),
+			}
+		}
+
+		interfaces[i] = ni
+	}
+
+	return interfaces
+}
+
+func (res routesResponse) toRoutes() []Route {
+	routes := make([]Route, len(res.Routes))
+
+	for i, r := range res.Routes {
+		routes[i] = Route{
+			Table:    r.Table,
+			Family:   r.Family,
+			Protocol: stringValue(r.Protocol),
+			Scope:    stringValue(r.Scope),
+			Type:     stringValue(r.Type),
+			Flags:    r.Flags,
+			Dst:      stringValue(r.Dst),
+			Gateway:  stringValue(r.Gateway),
+			Oif:      stringValue(r.Oif),
+			PrefSrc:  stringValue(r.PrefSrc),
+			Priority: r.Priority,
+		}
+	}
+
+	return routes
+}
+
+// Devices returns the devices found in Pi-hole's network table
+func (n network) Devices(ctx context.Context, opts NetworkDevicesOptions) (NetworkDeviceList, error) {
+	vals := url.Values{}
+	if opts.MaxDevices != 0 {
+		vals.Set("max_devices", strconv.Itoa(opts.MaxDevices))
+	}
+	if opts.MaxAddresses != 0 {
+		vals.Set("max_addresses", strconv.Itoa(opts.MaxAddresses))
+	}
+
+	path := "/api/network/devices"
+	if len(vals) > 0 {
+		path = fmt.Sprintf("%s?%s", path, vals.Encode())
+	}
+
+	var res networkDeviceListResponse
+	if err := n.client.getJSON(ctx, path, &res); err != nil {
+		return nil, err
+	}
+
+	return res.toNetworkDeviceList(), nil
+}
+
+// DeleteDevice removes a device from the network table by its ID
+func (n network) DeleteDevice(ctx context.Context, id int) error {
+	res, err := n.client.Delete(ctx, fmt.Sprintf("/api/network/devices/%d", id))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %d", ErrorNetworkDeviceNotFound, id)
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// Gateway returns the default gateways of the Pi-hole host
+func (n network) Gateway(ctx context.Context) ([]Gateway, error) {
+	var res gatewayResponse
+	if err := n.client.getJSON(ctx, "/api/network/gateway", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toGateways(), nil
+}
+
+// Interfaces returns the network interfaces of the Pi-hole host
+func (n network) Interfaces(ctx context.Context) ([]NetworkInterface, error) {
+	var res networkInterfacesResponse
+	if err := n.client.getJSON(ctx, "/api/network/interfaces", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toNetworkInterfaces(), nil
+}
+
+// Routes returns the routing table of the Pi-hole host
+func (n network) Routes(ctx context.Context) ([]Route, error) {
+	var res routesResponse
+	if err := n.client.getJSON(ctx, "/api/network/routes", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toRoutes(), nil
+}
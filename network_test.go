@@ -0,0 +1,47 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork(t *testing.T) {
+	t.Run("Test list network devices", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		devices, err := c.Network.Devices(context.Background(), NetworkDevicesOptions{MaxDevices: 5, MaxAddresses: 2})
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, len(devices), 5)
+		for _, device := range devices {
+			assert.LessOrEqual(t, len(device.IPs), 2)
+		}
+	})
+
+	t.Run("Test get gateway, interfaces and routes", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		gateways, err := c.Network.Gateway(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, gateways)
+
+		interfaces, err := c.Network.Interfaces(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, interfaces)
+
+		routes, err := c.Network.Routes(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, routes)
+	})
+}
@@ -0,0 +1,286 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Queries interface {
+	// List a page of queries matching the filter.
+	List(ctx context.Context, filter QueryFilter) (*QueryPage, error)
+
+	// Iterate returns an iterator over all pages of queries matching the filter.
+	Iterate(filter QueryFilter) *QueryIterator
+}
+
+type queries struct {
+	client *Client
+}
+
+// QueryFilter narrows the queries returned from the query log. Zero values are not sent.
+type QueryFilter struct {
+	From       time.Time
+	Until      time.Time
+	Domain     string
+	ClientIP   string
+	ClientName string
+	Upstream   string
+	Type       string
+	Status     string
+	Reply      string
+	DNSSEC     string
+
+	// Cursor is the ID of the most recent query to consider, as returned by a previous page.
+	Cursor int
+
+	// Length is the number of queries to return.
+	Length int
+
+	// Start is the number of queries to skip.
+	Start int
+}
+
+type Query struct {
+	ID         int
+	Time       time.Time
+	Type       string
+	Domain     string
+	CNAME      string
+	Status     string
+	ClientIP   string
+	ClientName string
+	DNSSEC     string
+	ReplyType  string
+	ReplyTime  time.Duration
+	ListID     *int
+	Upstream   string
+	EDECode    int
+	EDEText    string
+}
+
+type QueryList []Query
+
+// QueryPage is a page of queries along with the cursor used to request the following pages
+type QueryPage struct {
+	Queries         QueryList
+	Cursor          int
+	RecordsTotal    int
+	RecordsFiltered int
+}
+
+type queryListResponse struct {
+	Queries         []queryResponse `json:"queries"`
+	Cursor          int             `json:"cursor"`
+	RecordsTotal    int             `json:"recordsTotal"`
+	RecordsFiltered int             `json:"recordsFiltered"`
+}
+
+type queryResponse struct {
+	ID     int     `json:"id"`
+	Time   float64 `json:"time"`
+	Type   string  `json:"type"`
+	Domain string  `json:"domain"`
+	CNAME  *string `json:"cname"`
+	Status *string `json:"status"`
+	Client struct {
+		IP   string  `json:"ip"`
+		Name *string `json:"name"`
+	} `json:"client"`
+	DNSSEC *string `json:"dnssec"`
+	Reply  struct {
+		Type *string `json:"type"`
+		Time float64 `json:"time"`
+	} `json:"reply"`
+	ListID   *int    `json:"list_id"`
+	Upstream *string `json:"upstream"`
+	EDE      struct {
+		Code int     `json:"code"`
+		Text *string `json:"text"`
+	} `json:"ede"`
+}
+
+// stringValue dereferences a nullable string, returning an empty string for nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// unixTime converts fractional epoch seconds to a time
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+func (res queryResponse) toQuery() Query {
+	return Query{
+		ID:         res.ID,
+		Time:       unixTime(res.Time),
+		Type:       res.Type,
+		Domain:     res.Domain,
+		CNAME:      stringValue(res.CNAME),
+		Status:     stringValue(res.Status),
+		ClientIP:   res.Client.IP,
+		ClientName: stringValue(res.Client.Name),
+		DNSSEC:     stringValue(res.DNSSEC),
+		ReplyType:  stringValue(res.Reply.Type),
+		ReplyTime:  time.Duration(res.Reply.Time * float64(time.Millisecond)),
+		ListID:     res.ListID,
+		Upstream:   stringValue(res.Upstream),
+		EDECode:    res.EDE.Code,
+		EDEText:    stringValue(res.EDE.Text),
+	}
+}
+
+func (res queryListResponse) toQueryPage() *QueryPage {
+	list := make(QueryList, len(res.Queries))
+
+	for i, q := range res.Queries {
+		list[i] = q.toQuery()
+	}
+
+	return &QueryPage{
+		Queries:         list,
+		Cursor:          res.Cursor,
+		RecordsTotal:    res.RecordsTotal,
+		RecordsFiltered: res.RecordsFiltered,
+	}
+}
+
+func (f QueryFilter) values() url.Values {
+	vals := url.Values{}
+
+	if !f.From.IsZero() {
+		vals.Set("from", strconv.FormatInt(f.From.Unix(), 10))
+	}
+	if !f.Until.IsZero() {
+		vals.Set("until", strconv.FormatInt(f.Until.Unix(), 10))
+	}
+
+	for key, value := range map[string]string{
+		"domain":      f.Domain,
+		"client_ip":   f.ClientIP,
+		"client_name": f.ClientName,
+		"upstream":    f.Upstream,
+		"type":        f.Type,
+		"status":      f.Status,
+		"reply":       f.Reply,
+		"dnssec":      f.DNSSEC,
+	} {
+		if value != "" {
+			vals.Set(key, value)
+		}
+	}
+
+	if f.Cursor != 0 {
+		vals.Set("cursor", strconv.Itoa(f.Cursor))
+	}
+	if f.Length != 0 {
+		vals.Set("length", strconv.Itoa(f.Length))
+	}
+	if f.Start != 0 {
+		vals.Set("start", strconv.Itoa(f.Start))
+	}
+
+	return vals
+}
+
+// List returns a page of queries from the query log
+func (q queries) List(ctx context.Context, filter QueryFilter) (*QueryPage, error) {
+	path := "/api/queries"
+	if vals := filter.values(); len(vals) > 0 {
+		path = fmt.Sprintf("%s?%s", path, vals.Encode())
+	}
+
+	res, err := q.client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList queryListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse query list body: %w", err)
+	}
+
+	return resList.toQueryPage(), nil
+}
+
+// Iterate returns an iterator that pages through all queries matching the filter
+func (q queries) Iterate(filter QueryFilter) *QueryIterator {
+	return &QueryIterator{queries: q, filter: filter}
+}
+
+// QueryIterator walks the query log page by page, pinning the cursor returned by the first page so
+// queries arriving while iterating do not shift the results.
+//
+//	it := client.Queries.Iterate(pihole.QueryFilter{Domain: "example.com"})
+//	for it.Next(ctx) {
+//		query := it.Query()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type QueryIterator struct {
+	queries queries
+	filter  QueryFilter
+	page    QueryList
+	index   int
+	done    bool
+	err     error
+}
+
+// Next advances the iterator, fetching the next page when needed. It returns false once all queries
+// have been read or an error occurs.
+func (it *QueryIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.index+1 < len(it.page) {
+		it.index++
+		return true
+	}
+
+	if it.done {
+		return false
+	}
+
+	page, err := it.queries.List(ctx, it.filter)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.filter.Cursor = page.Cursor
+	it.filter.Start += len(page.Queries)
+
+	if len(page.Queries) == 0 || it.filter.Start >= page.RecordsFiltered {
+		it.done = true
+	}
+
+	it.page = page.Queries
+	it.index = 0
+
+	return len(it.page) > 0
+}
+
+// Query returns the current query.
+func (it *QueryIterator) Query() Query {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *QueryIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,77 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueriesIterate(t *testing.T) {
+	t.Run("Test iterate walks all pages", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		const total = 5
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+			length, _ := strconv.Atoi(r.URL.Query().Get("length"))
+
+			if start > 0 {
+				assert.Equal(t, "42", r.URL.Query().Get("cursor"))
+			}
+			assert.Equal(t, "example.com", r.URL.Query().Get("domain"))
+
+			var page []queryResponse
+			for i := start; i < total && i < start+length; i++ {
+				page = append(page, queryResponse{ID: 42 - i, Domain: "example.com"})
+			}
+
+			_ = json.NewEncoder(w).Encode(queryListResponse{
+				Queries:         page,
+				Cursor:          42,
+				RecordsTotal:    total,
+				RecordsFiltered: total,
+			})
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		it := c.Queries.Iterate(QueryFilter{Domain: "example.com", Length: 2})
+
+		var ids []int
+		for it.Next(ctx) {
+			ids = append(ids, it.Query().ID)
+		}
+		require.NoError(t, it.Err())
+
+		assert.Equal(t, []int{42, 41, 40, 39, 38}, ids)
+	})
+
+	t.Run("Test list queries", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		page, err := c.Queries.List(context.Background(), QueryFilter{
+			From:   time.Now().Add(-time.Hour),
+			Until:  time.Now(),
+			Length: 10,
+		})
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, len(page.Queries), 10)
+	})
+}
@@ -14,6 +14,11 @@ type SessionAPI interface {
 	Login(ctx context.Context) (Session, error)
 	Delete(ctx context.Context, sessionID string) error
 	Logout(ctx context.Context) error
+	List(ctx context.Context) ([]SessionDetails, error)
+	Revoke(ctx context.Context, id int) error
+	RevokeOthers(ctx context.Context) error
+	GenerateAppPassword(ctx context.Context) (AppPassword, error)
+	ApplyAppPassword(ctx context.Context, password AppPassword) error
 }
 
 type sessionAPI struct {
@@ -22,6 +27,7 @@ type sessionAPI struct {
 
 type sessionRequest struct {
 	Password string `json:"password"`
+	TOTP     *int   `json:"totp,omitempty"`
 }
 
 type sessionResponse struct {
@@ -51,6 +57,70 @@ type Session struct {
 	Expiration time.Time
 }
 
+// SessionDetails describes a session known to Pi-hole, as returned by List
+type SessionDetails struct {
+	ID         int
+	Current    bool
+	Valid      bool
+	RemoteAddr string
+	UserAgent  string
+	LoginAt    time.Time
+	LastActive time.Time
+	ValidUntil time.Time
+	App        bool
+	CLI        bool
+}
+
+// AppPassword is a generated application password. The password is only shown once, Pi-hole stores the
+// hash after it is applied.
+type AppPassword struct {
+	Password string
+	Hash     string
+}
+
+type appPasswordResponse struct {
+	App struct {
+		Password string `json:"password"`
+		Hash     string `json:"hash"`
+	} `json:"app"`
+}
+
+type sessionListResponse struct {
+	Sessions []struct {
+		ID         int     `json:"id"`
+		Current    bool    `json:"current_session"`
+		Valid      bool    `json:"valid"`
+		RemoteAddr string  `json:"remote_addr"`
+		UserAgent  *string `json:"user_agent"`
+		LoginAt    int64   `json:"login_at"`
+		LastActive int64   `json:"last_active"`
+		ValidUntil int64   `json:"valid_until"`
+		App        bool    `json:"app"`
+		CLI        bool    `json:"cli"`
+	} `json:"sessions"`
+}
+
+func (r sessionListResponse) toSessionDetails() []SessionDetails {
+	sessions := make([]SessionDetails, len(r.Sessions))
+
+	for i, s := range r.Sessions {
+		sessions[i] = SessionDetails{
+			ID:         s.ID,
+			Current:    s.Current,
+			Valid:      s.Valid,
+			RemoteAddr: s.RemoteAddr,
+			UserAgent:  stringValue(s.UserAgent),
+			LoginAt:    time.Unix(s.LoginAt, 0),
+			LastActive: time.Unix(s.LastActive, 0),
+			ValidUntil: time.Unix(s.ValidUntil, 0),
+			App:        s.App,
+			CLI:        s.CLI,
+		}
+	}
+
+	return sessions
+}
+
 func (r sessionResponse) ToSession() Session {
 	s := Session{
 		SID:        r.Session.SID,
@@ -67,6 +137,8 @@ var (
 	ErrorSessionUnauthorized    = errors.New("unauthorized session request")
 	ErrorSessionBadRequest      = errors.New("bad session request")
 	ErrorSessionTooManyRequests = errors.New("too many session requests")
+	ErrorSessionTOTPRequired    = errors.New("two-factor code required")
+	ErrorAppPasswordForbidden   = errors.New("forbidden for application password sessions")
 )
 
 // Login posts a login request using the stored client config and stores the session ID on the client
@@ -76,7 +148,7 @@ func (s *sessionAPI) Login(ctx context.Context) (Session, error) {
 		return Session{}, err
 	}
 
-	s.client.auth.sid = session.SID
+	s.client.auth.set(session)
 
 	return session, nil
 }
@@ -98,7 +170,7 @@ func (s *sessionAPI) Logout(ctx context.Context) error {
 	s.client.sessionLock.Lock()
 	defer s.client.sessionLock.Unlock()
 
-	s.client.auth.sid = ""
+	s.client.auth = auth{}
 
 	return nil
 }
@@ -124,9 +196,20 @@ func (s sessionAPI) logout(ctx context.Context) error {
 
 // Post creates a session
 func (s *sessionAPI) Post(ctx context.Context) (Session, error) {
-	res, err := s.client.Post(ctx, "/api/auth", sessionRequest{
+	req := sessionRequest{
 		Password: s.client.password,
-	})
+	}
+
+	if s.client.totp != nil {
+		code, err := s.client.totp(ctx)
+		if err != nil {
+			return Session{}, fmt.Errorf("failed to get two-factor code: %w", err)
+		}
+
+		req.TOTP = &code
+	}
+
+	res, err := s.client.Post(ctx, "/api/auth", req)
 	if err != nil {
 		return Session{}, err
 	}
@@ -142,6 +225,12 @@ func (s *sessionAPI) Post(ctx context.Context) (Session, error) {
 		return sesRes.ToSession(), nil
 	case http.StatusBadRequest:
 		return Session{}, fmt.Errorf("%w: %s", ErrorSessionBadRequest, sesRes.Error.Message)
+	case http.StatusUnauthorized:
+		if sesRes.Session.TOTP && s.client.totp == nil {
+			return Session{}, ErrorSessionTOTPRequired
+		}
+
+		return Session{}, fmt.Errorf("%w: %s", ErrorSessionUnauthorized, sesRes.Session.Message)
 	case http.StatusTooManyRequests:
 		return Session{}, fmt.Errorf("%w: %s", ErrorSessionTooManyRequests, sesRes.Error.Message)
 	default:
@@ -168,3 +257,107 @@ func (s *sessionAPI) Delete(ctx context.Context, sessionID string) error {
 		return fmt.Errorf("unexpected status code %d", res.StatusCode)
 	}
 }
+
+// List returns all sessions known to Pi-hole
+func (s *sessionAPI) List(ctx context.Context) ([]SessionDetails, error) {
+	res, err := s.client.Get(ctx, "/api/auth/sessions")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, ErrorSessionUnauthorized
+	default:
+		return nil, unexpectedStatus(res)
+	}
+
+	var listRes sessionListResponse
+	if err := json.NewDecoder(res.Body).Decode(&listRes); err != nil {
+		return nil, fmt.Errorf("failed to parse session list body: %w", err)
+	}
+
+	return listRes.toSessionDetails(), nil
+}
+
+// Revoke deletes a session by the ID returned from List
+func (s *sessionAPI) Revoke(ctx context.Context, id int) error {
+	res, err := s.client.Delete(ctx, fmt.Sprintf("/api/auth/session/%d", id))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %d", ErrorSessionNotFound, id)
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: %d", ErrorSessionUnauthorized, id)
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// RevokeOthers deletes every session except the one used by the client
+func (s *sessionAPI) RevokeOthers(ctx context.Context) error {
+	sessions, err := s.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		if session.Current {
+			continue
+		}
+
+		if err := s.Revoke(ctx, session.ID); err != nil && !errors.Is(err, ErrorSessionNotFound) {
+			return fmt.Errorf("failed to revoke session %d: %w", session.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// GenerateAppPassword returns a new application password. It only takes effect once applied with
+// ApplyAppPassword.
+func (s *sessionAPI) GenerateAppPassword(ctx context.Context) (AppPassword, error) {
+	res, err := s.client.Get(ctx, "/api/auth/app")
+	if err != nil {
+		return AppPassword{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return AppPassword{}, unexpectedStatus(res)
+	}
+
+	var appRes appPasswordResponse
+	if err := json.NewDecoder(res.Body).Decode(&appRes); err != nil {
+		return AppPassword{}, fmt.Errorf("failed to parse app password body: %w", err)
+	}
+
+	return AppPassword{
+		Password: appRes.App.Password,
+		Hash:     appRes.App.Hash,
+	}, nil
+}
+
+// ApplyAppPassword stores the hash of an application password, replacing any previous application password
+func (s *sessionAPI) ApplyAppPassword(ctx context.Context, password AppPassword) error {
+	_, err := s.client.ConfigAPI.Patch(ctx, map[string]interface{}{
+		"webserver": map[string]interface{}{
+			"api": map[string]interface{}{
+				"app_pwhash": password.Hash,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply app password: %w", err)
+	}
+
+	return nil
+}
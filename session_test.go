@@ -2,6 +2,11 @@ package pihole
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,3 +66,175 @@ func TestSessionLogout(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionRevokeOthers(t *testing.T) {
+	t.Run("Revoke all sessions except the current one", func(t *testing.T) {
+		ctx := context.TODO()
+
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		other := newTestClient(t)
+		_, err := other.SessionAPI.Login(ctx)
+		require.NoError(t, err)
+
+		_, err = c.SessionAPI.Login(ctx)
+		require.NoError(t, err)
+
+		sessions, err := c.SessionAPI.List(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(sessions), 2)
+
+		err = c.SessionAPI.RevokeOthers(ctx)
+		require.NoError(t, err)
+
+		sessions, err = c.SessionAPI.List(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].Current)
+	})
+}
+
+func TestSessionTOTP(t *testing.T) {
+	newServer := func(t *testing.T) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req sessionRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			if req.TOTP == nil || *req.TOTP != 123456 {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"session":{"valid":false,"totp":true,"sid":null,"validity":-1,"message":"no 2FA token found"}}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"session":{"valid":true,"totp":true,"sid":"test","validity":300}}`)
+		}))
+	}
+
+	t.Run("Test sends the two-factor code", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := newServer(t)
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test", TOTP: StaticTOTP(123456)})
+		require.NoError(t, err)
+
+		session, err := c.SessionAPI.Login(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "test", session.SID)
+		assert.True(t, session.TOTP)
+	})
+
+	t.Run("Test sends a two-factor code of zero", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			assert.Equal(t, float64(0), req["totp"])
+
+			fmt.Fprint(w, `{"session":{"valid":true,"totp":true,"sid":"test","validity":300}}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test", TOTP: StaticTOTP(0)})
+		require.NoError(t, err)
+
+		_, err = c.SessionAPI.Login(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Test returns an error when the two-factor code is missing", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := newServer(t)
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test"})
+		require.NoError(t, err)
+
+		_, err = c.SessionAPI.Login(context.Background())
+		assert.ErrorIs(t, err, ErrorSessionTOTPRequired)
+	})
+
+	t.Run("Test returns an error when the two-factor code is wrong", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := newServer(t)
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, Password: "test", TOTP: StaticTOTP(654321)})
+		require.NoError(t, err)
+
+		_, err = c.SessionAPI.Login(context.Background())
+		assert.ErrorIs(t, err, ErrorSessionUnauthorized)
+	})
+}
+
+func TestSessionAppPassword(t *testing.T) {
+	t.Run("Test returns an error when an app password session is forbidden", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/api/config", r.URL.Path)
+
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"key":"forbidden","message":"Unable to change configuration (read-only)","hint":"The current app session is not allowed to modify Pi-hole config settings (webserver.api.app_sudo is false)"}}`)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test", AppPassword: true})
+		require.NoError(t, err)
+
+		err = c.SessionAPI.ApplyAppPassword(context.Background(), AppPassword{Hash: "hash"})
+		assert.ErrorIs(t, err, ErrorAppPasswordForbidden)
+	})
+
+	t.Run("Test generate, apply and login with an app password", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		password, err := c.SessionAPI.GenerateAppPassword(ctx)
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, password.Password)
+		assert.NotEmpty(t, password.Hash)
+
+		require.NoError(t, c.SessionAPI.ApplyAppPassword(ctx, password))
+		defer func() {
+			if err := c.SessionAPI.ApplyAppPassword(ctx, AppPassword{}); err != nil {
+				fmt.Printf("failed to clean up app password: %s\n", err)
+			}
+		}()
+
+		app, err := New(Config{
+			BaseURL:     os.Getenv("PIHOLE_URL"),
+			Password:    password.Password,
+			AppPassword: true,
+		})
+		require.NoError(t, err)
+		defer cleanupTestClient(app)
+
+		_, err = app.Blocking.Get(ctx)
+		require.NoError(t, err)
+
+		_, err = app.ConfigAPI.Patch(ctx, map[string]interface{}{"misc": map[string]interface{}{"nice": -10}})
+		assert.ErrorIs(t, err, ErrorAppPasswordForbidden)
+	})
+}
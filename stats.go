@@ -0,0 +1,344 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Stats interface {
+	// Summary of the current query statistics.
+	Summary(ctx context.Context) (*StatsSummary, error)
+
+	// TopDomains returns the most queried domains.
+	TopDomains(ctx context.Context, opts StatsOptions) (*TopDomains, error)
+
+	// TopClients returns the most active clients.
+	TopClients(ctx context.Context, opts StatsOptions) (*TopClients, error)
+
+	// Upstreams returns the query counts and response times of the upstream servers.
+	Upstreams(ctx context.Context, opts StatsOptions) (*UpstreamStats, error)
+
+	// QueryTypes returns the number of queries by query type.
+	QueryTypes(ctx context.Context, opts StatsOptions) (map[string]int, error)
+
+	// RecentBlocked returns the most recently blocked domains.
+	RecentBlocked(ctx context.Context, count int) ([]string, error)
+}
+
+type stats struct {
+	client *Client
+}
+
+// StatsOptions narrows statistics requests. Setting From or Until reads the statistics from the long-term
+// database rather than memory, from its start when From is zero and up to now when Until is zero. Not every
+// option applies to every endpoint.
+type StatsOptions struct {
+	Blocked bool
+	Count   int
+	From    time.Time
+	Until   time.Time
+}
+
+type StatsSummary struct {
+	Queries StatsQueries
+	Clients StatsClients
+	Gravity StatsGravity
+}
+
+type StatsQueries struct {
+	Total          int
+	Blocked        int
+	PercentBlocked float64
+	UniqueDomains  int
+	Forwarded      int
+	Cached         int
+	Frequency      float64
+	Types          map[string]int
+	Status         map[string]int
+	Replies        map[string]int
+}
+
+type StatsClients struct {
+	Active int
+	Total  int
+}
+
+type StatsGravity struct {
+	DomainsBeingBlocked int
+	LastUpdate          time.Time
+}
+
+type TopDomains struct {
+	Domains        []DomainCount
+	TotalQueries   int
+	BlockedQueries int
+}
+
+type DomainCount struct {
+	Domain string
+	Count  int
+}
+
+type TopClients struct {
+	Clients        []ClientCount
+	TotalQueries   int
+	BlockedQueries int
+}
+
+type ClientCount struct {
+	IP    string
+	Name  string
+	Count int
+}
+
+type UpstreamStats struct {
+	Upstreams        []UpstreamCount
+	ForwardedQueries int
+	TotalQueries     int
+}
+
+type UpstreamCount struct {
+	IP       string
+	Name     string
+	Port     int
+	Count    int
+	Response time.Duration
+	Variance float64
+}
+
+type statsSummaryResponse struct {
+	Queries struct {
+		Total          int            `json:"total"`
+		Blocked        int            `json:"blocked"`
+		PercentBlocked float64        `json:"percent_blocked"`
+		UniqueDomains  int            `json:"unique_domains"`
+		Forwarded      int            `json:"forwarded"`
+		Cached         int            `json:"cached"`
+		Frequency      float64        `json:"frequency"`
+		Types          map[string]int `json:"types"`
+		Status         map[string]int `json:"status"`
+		Replies        map[string]int `json:"replies"`
+	} `json:"queries"`
+	Clients struct {
+		Active int `json:"active"`
+		Total  int `json:"total"`
+	} `json:"clients"`
+	Gravity struct {
+		DomainsBeingBlocked int   `json:"domains_being_blocked"`
+		LastUpdate          int64 `json:"last_update"`
+	} `json:"gravity"`
+}
+
+type topDomainsResponse struct {
+	Domains []struct {
+		Domain string `json:"domain"`
+		Count  int    `json:"count"`
+	} `json:"domains"`
+	TotalQueries   int `json:"total_queries"`
+	BlockedQueries int `json:"blocked_queries"`
+}
+
+type topClientsResponse struct {
+	Clients []struct {
+		IP    string  `json:"ip"`
+		Name  *string `json:"name"`
+		Count int     `json:"count"`
+	} `json:"clients"`
+	TotalQueries   int `json:"total_queries"`
+	BlockedQueries int `json:"blocked_queries"`
+}
+
+type upstreamStatsResponse struct {
+	Upstreams []struct {
+		IP         *string `json:"ip"`
+		Name       *string `json:"name"`
+		Port       int     `json:"port"`
+		Count      int     `json:"count"`
+		Statistics struct {
+			Response float64 `json:"response"`
+			Variance float64 `json:"variance"`
+		} `json:"statistics"`
+	} `json:"upstreams"`
+	ForwardedQueries int `json:"forwarded_queries"`
+	TotalQueries     int `json:"total_queries"`
+}
+
+type queryTypesResponse struct {
+	Types map[string]int `json:"types"`
+}
+
+type recentBlockedResponse struct {
+	Blocked []string `json:"blocked"`
+}
+
+func (res statsSummaryResponse) toStatsSummary() *StatsSummary {
+	return &StatsSummary{
+		Queries: StatsQueries{
+			Total:          res.Queries.Total,
+			Blocked:        res.Queries.Blocked,
+			PercentBlocked: res.Queries.PercentBlocked,
+			UniqueDomains:  res.Queries.UniqueDomains,
+			Forwarded:      res.Queries.Forwarded,
+			Cached:         res.Queries.Cached,
+			Frequency:      res.Queries.Frequency,
+			Types:          res.Queries.Types,
+			Status:         res.Queries.Status,
+			Replies:        res.Queries.Replies,
+		},
+		Clients: StatsClients{
+			Active: res.Clients.Active,
+			Total:  res.Clients.Total,
+		},
+		Gravity: StatsGravity{
+			DomainsBeingBlocked: res.Gravity.DomainsBeingBlocked,
+			LastUpdate:          time.Unix(res.Gravity.LastUpdate, 0),
+		},
+	}
+}
+
+func (res topDomainsResponse) toTopDomains() *TopDomains {
+	top := &TopDomains{
+		Domains:        make([]DomainCount, len(res.Domains)),
+		TotalQueries:   res.TotalQueries,
+		BlockedQueries: res.BlockedQueries,
+	}
+
+	for i, d := range res.Domains {
+		top.Domains[i] = DomainCount{Domain: d.Domain, Count: d.Count}
+	}
+
+	return top
+}
+
+func (res topClientsResponse) toTopClients() *TopClients {
+	top := &TopClients{
+		Clients:        make([]ClientCount, len(res.Clients)),
+		TotalQueries:   res.TotalQueries,
+		BlockedQueries: res.BlockedQueries,
+	}
+
+	for i, c := range res.Clients {
+		top.Clients[i] = ClientCount{IP: c.IP, Name: stringValue(c.Name), Count: c.Count}
+	}
+
+	return top
+}
+
+func (res upstreamStatsResponse) toUpstreamStats() *UpstreamStats {
+	upstreams := &UpstreamStats{
+		Upstreams:        make([]UpstreamCount, len(res.Upstreams)),
+		ForwardedQueries: res.ForwardedQueries,
+		TotalQueries:     res.TotalQueries,
+	}
+
+	for i, u := range res.Upstreams {
+		upstreams.Upstreams[i] = UpstreamCount{
+			IP:       stringValue(u.IP),
+			Name:     stringValue(u.Name),
+			Port:     u.Port,
+			Count:    u.Count,
+			Response: time.Duration(u.Statistics.Response * float64(time.Second)),
+			Variance: u.Statistics.Variance,
+		}
+	}
+
+	return upstreams
+}
+
+// path returns the in-memory or database endpoint for a statistic along with the applicable options
+func (opts StatsOptions) path(endpoint string) string {
+	vals := url.Values{}
+
+	path := fmt.Sprintf("/api/stats/%s", endpoint)
+	if !opts.From.IsZero() || !opts.Until.IsZero() {
+		path = fmt.Sprintf("/api/stats/database/%s", endpoint)
+
+		var from int64
+		if !opts.From.IsZero() {
+			from = opts.From.Unix()
+		}
+		vals.Set("from", strconv.FormatInt(from, 10))
+
+		until := opts.Until
+		if until.IsZero() {
+			until = time.Now()
+		}
+		vals.Set("until", strconv.FormatInt(until.Unix(), 10))
+	}
+
+	if opts.Blocked {
+		vals.Set("blocked", "true")
+	}
+	if opts.Count != 0 {
+		vals.Set("count", strconv.Itoa(opts.Count))
+	}
+
+	if len(vals) == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?%s", path, vals.Encode())
+}
+
+// Summary returns the current query statistics
+func (s stats) Summary(ctx context.Context) (*StatsSummary, error) {
+	var res statsSummaryResponse
+	if err := s.client.getJSON(ctx, "/api/stats/summary", &res); err != nil {
+		return nil, err
+	}
+
+	return res.toStatsSummary(), nil
+}
+
+// TopDomains returns the most queried, or with Blocked set the most blocked, domains
+func (s stats) TopDomains(ctx context.Context, opts StatsOptions) (*TopDomains, error) {
+	var res topDomainsResponse
+	if err := s.client.getJSON(ctx, opts.path("top_domains"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.toTopDomains(), nil
+}
+
+// TopClients returns the most active, or with Blocked set the most blocked, clients
+func (s stats) TopClients(ctx context.Context, opts StatsOptions) (*TopClients, error) {
+	var res topClientsResponse
+	if err := s.client.getJSON(ctx, opts.path("top_clients"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.toTopClients(), nil
+}
+
+// Upstreams returns the query counts and response times of the upstream servers
+func (s stats) Upstreams(ctx context.Context, opts StatsOptions) (*UpstreamStats, error) {
+	var res upstreamStatsResponse
+	if err := s.client.getJSON(ctx, opts.path("upstreams"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.toUpstreamStats(), nil
+}
+
+// QueryTypes returns the number of queries by query type
+func (s stats) QueryTypes(ctx context.Context, opts StatsOptions) (map[string]int, error) {
+	var res queryTypesResponse
+	if err := s.client.getJSON(ctx, opts.path("query_types"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.Types, nil
+}
+
+// RecentBlocked returns the most recently blocked domains, newest first
+func (s stats) RecentBlocked(ctx context.Context, count int) ([]string, error) {
+	var res recentBlockedResponse
+	if err := s.client.getJSON(ctx, StatsOptions{Count: count}.path("recent_blocked"), &res); err != nil {
+		return nil, err
+	}
+
+	return res.Blocked, nil
+}
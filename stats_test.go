@@ -0,0 +1,84 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsOptionsPath(t *testing.T) {
+	from := time.Unix(1700000000, 0)
+	until := time.Unix(1700003600, 0)
+
+	tcs := []struct {
+		name     string
+		opts     StatsOptions
+		expected string
+	}{
+		{
+			name:     "no options",
+			opts:     StatsOptions{},
+			expected: "/api/stats/top_domains",
+		},
+		{
+			name:     "blocked and count",
+			opts:     StatsOptions{Blocked: true, Count: 5},
+			expected: "/api/stats/top_domains?blocked=true&count=5",
+		},
+		{
+			name:     "time range reads from the database",
+			opts:     StatsOptions{From: from, Until: until},
+			expected: "/api/stats/database/top_domains?from=1700000000&until=1700003600",
+		},
+		{
+			name:     "until only reads from the start of the database",
+			opts:     StatsOptions{Until: until},
+			expected: "/api/stats/database/top_domains?from=0&until=1700003600",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			isUnit(t)
+
+			assert.Equal(t, tc.expected, tc.opts.path("top_domains"))
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	t.Run("Test get stats", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		summary, err := c.Stats.Summary(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, summary.Queries.Total, summary.Queries.Blocked)
+
+		_, err = c.Stats.TopDomains(ctx, StatsOptions{Count: 5})
+		require.NoError(t, err)
+
+		_, err = c.Stats.TopClients(ctx, StatsOptions{Blocked: true})
+		require.NoError(t, err)
+
+		_, err = c.Stats.Upstreams(ctx, StatsOptions{})
+		require.NoError(t, err)
+
+		types, err := c.Stats.QueryTypes(ctx, StatsOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, types, "A")
+
+		_, err = c.Stats.RecentBlocked(ctx, 3)
+		require.NoError(t, err)
+
+		_, err = c.Stats.TopDomains(ctx, StatsOptions{From: time.Now().Add(-time.Hour), Until: time.Now()})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,148 @@
+package pihole
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+type Teleporter interface {
+	// Export writes a zip backup of the Pi-hole configuration and databases to w.
+	Export(ctx context.Context, w io.Writer) error
+
+	// Import restores a zip backup read from r, returning the files that were imported. Nil options import everything.
+	Import(ctx context.Context, r io.Reader, opts *TeleporterImportOptions) ([]string, error)
+}
+
+type teleporter struct {
+	client *Client
+}
+
+// TeleporterImportOptions selects which parts of a backup to restore
+type TeleporterImportOptions struct {
+	Config     bool
+	DHCPLeases bool
+	Gravity    TeleporterGravityOptions
+}
+
+// TeleporterGravityOptions selects which gravity database tables to restore
+type TeleporterGravityOptions struct {
+	Group             bool
+	Adlist            bool
+	AdlistByGroup     bool
+	Domainlist        bool
+	DomainlistByGroup bool
+	Client            bool
+	ClientByGroup     bool
+}
+
+type teleporterImportRequest struct {
+	Config     bool                           `json:"config"`
+	DHCPLeases bool                           `json:"dhcp_leases"`
+	Gravity    teleporterGravityImportRequest `json:"gravity"`
+}
+
+type teleporterGravityImportRequest struct {
+	Group             bool `json:"group"`
+	Adlist            bool `json:"adlist"`
+	AdlistByGroup     bool `json:"adlist_by_group"`
+	Domainlist        bool `json:"domainlist"`
+	DomainlistByGroup bool `json:"domainlist_by_group"`
+	Client            bool `json:"client"`
+	ClientByGroup     bool `json:"client_by_group"`
+}
+
+type teleporterImportResponse struct {
+	Files []string `json:"files"`
+}
+
+func (opts TeleporterImportOptions) toRequest() teleporterImportRequest {
+	return teleporterImportRequest{
+		Config:     opts.Config,
+		DHCPLeases: opts.DHCPLeases,
+		Gravity: teleporterGravityImportRequest{
+			Group:             opts.Gravity.Group,
+			Adlist:            opts.Gravity.Adlist,
+			AdlistByGroup:     opts.Gravity.AdlistByGroup,
+			Domainlist:        opts.Gravity.Domainlist,
+			DomainlistByGroup: opts.Gravity.DomainlistByGroup,
+			Client:            opts.Gravity.Client,
+			ClientByGroup:     opts.Gravity.ClientByGroup,
+		},
+	}
+}
+
+// Export streams a zip backup to w
+func (t teleporter) Export(ctx context.Context, w io.Writer) error {
+	res, err := t.client.Get(ctx, "/api/teleporter")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return unexpectedStatus(res)
+	}
+
+	if _, err := io.Copy(w, res.Body); err != nil {
+		return fmt.Errorf("failed to write teleporter backup: %w", err)
+	}
+
+	return nil
+}
+
+// Import uploads a zip backup and restores the selected parts of it
+func (t teleporter) Import(ctx context.Context, r io.Reader, opts *TeleporterImportOptions) ([]string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="pihole-backup.zip"`)
+	header.Set("Content-Type", "application/zip")
+
+	file, err := form.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create teleporter upload: %w", err)
+	}
+
+	if _, err := io.Copy(file, r); err != nil {
+		return nil, fmt.Errorf("failed to read teleporter backup: %w", err)
+	}
+
+	if opts != nil {
+		selection, err := json.Marshal(opts.toRequest())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode teleporter import options: %w", err)
+		}
+
+		if err := form.WriteField("import", string(selection)); err != nil {
+			return nil, fmt.Errorf("failed to create teleporter upload: %w", err)
+		}
+	}
+
+	if err := form.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create teleporter upload: %w", err)
+	}
+
+	res, err := t.client.send(ctx, http.MethodPost, "/api/teleporter", body.Bytes(), form.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var importRes teleporterImportResponse
+	if err := json.NewDecoder(res.Body).Decode(&importRes); err != nil {
+		return nil, fmt.Errorf("failed to parse teleporter response body: %w", err)
+	}
+
+	return importRes.Files, nil
+}
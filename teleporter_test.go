@@ -0,0 +1,39 @@
+package pihole
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeleporter(t *testing.T) {
+	t.Run("Test export and import a backup", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		var backup bytes.Buffer
+		err := c.Teleporter.Export(ctx, &backup)
+		require.NoError(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(backup.Bytes()), int64(backup.Len()))
+		require.NoError(t, err)
+		assert.NotEmpty(t, archive.File)
+
+		files, err := c.Teleporter.Import(ctx, &backup, &TeleporterImportOptions{
+			Gravity: TeleporterGravityOptions{
+				Group: true,
+			},
+		})
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, files)
+	})
+}
@@ -0,0 +1,57 @@
+package pihole
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TOTPSource returns the current two-factor code to log in with
+type TOTPSource func(ctx context.Context) (int, error)
+
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 1000000
+)
+
+// StaticTOTP always returns the same code, which is only useful for a single login
+func StaticTOTP(code int) TOTPSource {
+	return func(ctx context.Context) (int, error) {
+		return code, nil
+	}
+}
+
+// TOTPFromSecret generates RFC 6238 codes from the base32 secret shown when two-factor authentication
+// was enabled in Pi-hole
+func TOTPFromSecret(secret string) (TOTPSource, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+
+	return func(ctx context.Context) (int, error) {
+		return totpCode(key, time.Now()), nil
+	}, nil
+}
+
+// totpCode computes the six digit code for the time step containing t
+func totpCode(key []byte, t time.Time) int {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix()/int64(totpPeriod/time.Second)))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return int(code % totpDigits)
+}
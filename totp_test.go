@@ -0,0 +1,55 @@
+package pihole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	tcs := []struct {
+		unix int64
+		code int
+	}{
+		{unix: 59, code: 287082},
+		{unix: 1111111109, code: 81804},
+		{unix: 1234567890, code: 5924},
+		{unix: 2000000000, code: 279037},
+	}
+
+	for _, tc := range tcs {
+		t.Run(time.Unix(tc.unix, 0).UTC().String(), func(t *testing.T) {
+			isUnit(t)
+			t.Parallel()
+
+			// RFC 6238 SHA1 test vectors truncated to six digits
+			assert.Equal(t, tc.code, totpCode([]byte("12345678901234567890"), time.Unix(tc.unix, 0)))
+		})
+	}
+}
+
+func TestTOTPFromSecret(t *testing.T) {
+	t.Run("Test accepts unpadded lower case secrets", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		source, err := TOTPFromSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+		require.NoError(t, err)
+
+		code, err := source(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, totpCode([]byte("12345678901234567890"), time.Now()), code)
+	})
+
+	t.Run("Test rejects an invalid secret", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		_, err := TOTPFromSecret("not-base32!")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,214 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type Upstreams interface {
+	// List all upstream DNS servers.
+	List(ctx context.Context) (UpstreamList, error)
+
+	// Add an upstream DNS server.
+	Add(ctx context.Context, upstream string) (*Upstream, error)
+
+	// Remove an upstream DNS server.
+	Remove(ctx context.Context, upstream string) error
+
+	// Replace all upstream DNS servers.
+	Replace(ctx context.Context, upstreams []string) (UpstreamList, error)
+}
+
+var (
+	ErrorUpstreamInvalid = errors.New("invalid upstream DNS server")
+)
+
+type upstreams struct {
+	client *Client
+}
+
+// Upstream is a DNS server Pi-hole forwards queries to. Pi-hole does not speak DNS-over-TLS itself, so
+// DoT resolvers are reached through a local forwarder such as 127.0.0.1#5335.
+type Upstream struct {
+	IP   string
+	Port int
+}
+
+type UpstreamList []Upstream
+
+type upstreamListResponse struct {
+	Config struct {
+		DNS struct {
+			Upstreams []string `json:"upstreams"`
+		} `json:"dns"`
+	} `json:"config"`
+}
+
+// String formats the upstream in Pi-hole's IP[#port] syntax
+func (u Upstream) String() string {
+	if u.Port == 0 {
+		return u.IP
+	}
+
+	return fmt.Sprintf("%s#%d", u.IP, u.Port)
+}
+
+// ParseUpstream parses an upstream in Pi-hole's IP[#port] syntax, such as 1.1.1.1, 127.0.0.1#5335 or
+// 2606:4700:4700::1111#53
+func ParseUpstream(value string) (Upstream, error) {
+	host, port, hasPort := strings.Cut(value, "#")
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return Upstream{}, fmt.Errorf("%w: %q is not an IP address", ErrorUpstreamInvalid, value)
+	}
+
+	upstream := Upstream{IP: ip.String()}
+
+	if hasPort {
+		p, err := strconv.Atoi(port)
+		if err != nil || p < 1 || p > 65535 {
+			return Upstream{}, fmt.Errorf("%w: %q has an invalid port", ErrorUpstreamInvalid, value)
+		}
+
+		upstream.Port = p
+	}
+
+	return upstream, nil
+}
+
+func parseUpstreams(values []string) (UpstreamList, error) {
+	list := make(UpstreamList, len(values))
+
+	for i, value := range values {
+		upstream, err := ParseUpstream(value)
+		if err != nil {
+			return nil, err
+		}
+
+		list[i] = upstream
+	}
+
+	return list, nil
+}
+
+// List returns the upstream DNS servers
+func (u upstreams) List(ctx context.Context) (UpstreamList, error) {
+	entries, err := u.entries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseUpstreams(entries)
+}
+
+// entries returns the upstream DNS servers as stored by Pi-hole
+func (u upstreams) entries(ctx context.Context) ([]string, error) {
+	res, err := u.client.Get(ctx, "/api/config/dns/upstreams")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(res)
+	}
+
+	var resList upstreamListResponse
+	if err := json.NewDecoder(res.Body).Decode(&resList); err != nil {
+		return nil, fmt.Errorf("failed to parse upstream list body: %w", err)
+	}
+
+	return resList.Config.DNS.Upstreams, nil
+}
+
+// Add appends an upstream DNS server
+func (u upstreams) Add(ctx context.Context, upstream string) (*Upstream, error) {
+	parsed, err := ParseUpstream(upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.client.Put(ctx, fmt.Sprintf("/api/config/dns/upstreams/%s", url.PathEscape(parsed.String())), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, unexpectedStatus(res)
+	}
+
+	return &parsed, nil
+}
+
+// Remove deletes an upstream DNS server. The server is matched by address, so 2001:DB8::1 removes a stored
+// 2001:db8::1, and removing a server that is not configured is not an error.
+func (u upstreams) Remove(ctx context.Context, upstream string) error {
+	parsed, err := ParseUpstream(upstream)
+	if err != nil {
+		return err
+	}
+
+	entries, err := u.entries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list upstreams: %w", err)
+	}
+
+	var entry string
+	for _, e := range entries {
+		if stored, err := ParseUpstream(e); err == nil && stored == parsed {
+			entry = e
+			break
+		}
+	}
+
+	if entry == "" {
+		return nil
+	}
+
+	res, err := u.client.Delete(ctx, fmt.Sprintf("/api/config/dns/upstreams/%s", url.PathEscape(entry)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return unexpectedStatus(res)
+	}
+}
+
+// Replace sets the upstream DNS servers, validating every server before any change is made
+func (u upstreams) Replace(ctx context.Context, upstreams []string) (UpstreamList, error) {
+	values := make([]string, len(upstreams))
+
+	for i, upstream := range upstreams {
+		parsed, err := ParseUpstream(upstream)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = parsed.String()
+	}
+
+	config, err := u.client.ConfigAPI.Patch(ctx, map[string]interface{}{
+		"dns": map[string]interface{}{
+			"upstreams": values,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace upstreams: %w", err)
+	}
+
+	return parseUpstreams(config.DNS.Upstreams)
+}
@@ -0,0 +1,135 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUpstream(t *testing.T) {
+	tcs := []struct {
+		value    string
+		expected Upstream
+		err      bool
+	}{
+		{value: "1.1.1.1", expected: Upstream{IP: "1.1.1.1"}},
+		{value: "127.0.0.1#5335", expected: Upstream{IP: "127.0.0.1", Port: 5335}},
+		{value: "2606:4700:4700::1111#853", expected: Upstream{IP: "2606:4700:4700::1111", Port: 853}},
+		{value: "::1", expected: Upstream{IP: "::1"}},
+		{value: "dns.google", err: true},
+		{value: "1.1.1.1#", err: true},
+		{value: "1.1.1.1#70000", err: true},
+		{value: "1.1.1.1:53", err: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.value, func(t *testing.T) {
+			isUnit(t)
+
+			upstream, err := ParseUpstream(tc.value)
+			if tc.err {
+				assert.ErrorIs(t, err, ErrorUpstreamInvalid)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, upstream)
+			assert.Equal(t, tc.value, upstream.String())
+		})
+	}
+}
+
+func TestUpstreams(t *testing.T) {
+	t.Run("Test remove deletes the stored entry", func(t *testing.T) {
+		isUnit(t)
+		t.Parallel()
+
+		var deleted []string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				fmt.Fprint(w, `{"config":{"dns":{"upstreams":["1.1.1.1","2001:DB8::1#53"]}}}`)
+				return
+			}
+
+			assert.Equal(t, http.MethodDelete, r.Method)
+			deleted = append(deleted, r.URL.Path)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		c, err := New(Config{BaseURL: server.URL, SessionID: "test"})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		require.NoError(t, c.Upstreams.Remove(ctx, "2001:db8::1#53"))
+		require.NoError(t, c.Upstreams.Remove(ctx, "9.9.9.9"))
+
+		assert.Equal(t, []string{"/api/config/dns/upstreams/2001:DB8::1#53"}, deleted)
+	})
+
+	t.Run("Test add and remove an upstream", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		upstream, err := c.Upstreams.Add(ctx, "127.0.0.1#5335")
+		require.NoError(t, err)
+		defer func() {
+			if err := c.Upstreams.Remove(ctx, upstream.String()); err != nil {
+				log.Printf("Failed to clean up upstream: %s\n", upstream)
+			}
+		}()
+
+		list, err := c.Upstreams.List(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, list, *upstream)
+
+		err = c.Upstreams.Remove(ctx, upstream.String())
+		require.NoError(t, err)
+
+		list, err = c.Upstreams.List(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, list, *upstream)
+	})
+
+	t.Run("Test replace upstreams", func(t *testing.T) {
+		isAcceptance(t)
+
+		c := newTestClient(t)
+		defer cleanupTestClient(c)
+
+		ctx := context.Background()
+
+		original, err := c.Upstreams.List(ctx)
+		require.NoError(t, err)
+		defer func() {
+			values := make([]string, len(original))
+			for i, u := range original {
+				values[i] = u.String()
+			}
+			if _, err := c.Upstreams.Replace(ctx, values); err != nil {
+				log.Printf("Failed to restore upstreams: %s\n", err)
+			}
+		}()
+
+		list, err := c.Upstreams.Replace(ctx, []string{"1.1.1.1", "1.0.0.1#53"})
+		require.NoError(t, err)
+
+		assert.Equal(t, UpstreamList{{IP: "1.1.1.1"}, {IP: "1.0.0.1", Port: 53}}, list)
+
+		_, err = c.Upstreams.Replace(ctx, []string{"not-an-ip"})
+		assert.ErrorIs(t, err, ErrorUpstreamInvalid)
+	})
+}